@@ -0,0 +1,21 @@
+resource "tines_story" "example_imported_story" {
+  team_id = 1
+  data    = file("${path.module}/story-example.json")
+}
+
+data "tines_actions" "example" {
+  story_id = tines_story.example_imported_story.id
+}
+
+locals {
+  actions_by_name = { for action in data.tines_actions.example.actions : action.name => action.id }
+}
+
+# Select the Send to Story entry and exit actions by name.
+resource "tines_story" "example_configured_story" {
+  team_id               = 1
+  name                  = "Example Story Name"
+  send_to_story_enabled = true
+  entry_agent_id        = local.actions_by_name["Webhook Action"]
+  exit_agents           = [local.actions_by_name["Event Transform Action"]]
+}
@@ -0,0 +1,19 @@
+resource "tines_story" "example_imported_story" {
+  team_id = 1
+  data    = file("${path.module}/story-example.json")
+}
+
+# Confirm nobody has edited the managed story by hand in the last 24 hours.
+check "no_manual_story_changes" {
+  data "tines_audit_logs" "recent_story_changes" {
+    after           = timeadd(plantimestamp(), "-24h")
+    operation_names = ["StoryUpdate", "ActionsUpdate"]
+    target_type     = "Story"
+    target_id       = tines_story.example_imported_story.id
+  }
+
+  assert {
+    condition     = length(data.tines_audit_logs.recent_story_changes.audit_logs) == 0
+    error_message = "The story has been changed outside of Terraform in the last 24 hours."
+  }
+}
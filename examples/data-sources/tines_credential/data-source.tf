@@ -0,0 +1,10 @@
+# Look up a Tines Credential by name within a team.
+data "tines_credential" "example_by_name" {
+  team_id = 1
+  name    = "github_api_token"
+}
+
+# Look up a Tines Credential by ID.
+data "tines_credential" "example_by_id" {
+  id = 123
+}
@@ -0,0 +1,12 @@
+# List every Tines Credential in a team.
+data "tines_credentials" "example" {
+  team_id = 1
+}
+
+# Fail the plan if a credential referenced by a story export is missing.
+check "story_credentials_exist" {
+  assert {
+    condition     = contains(data.tines_credentials.example.credentials[*].name, "github_api_token")
+    error_message = "The github_api_token credential must exist before the story is imported."
+  }
+}
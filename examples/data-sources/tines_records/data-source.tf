@@ -0,0 +1,22 @@
+# Read the allowlisted sender domains maintained in Tines Records.
+data "tines_records" "allowlisted_domains" {
+  record_type_id = 1
+
+  filters = [
+    {
+      field    = "Status"
+      operator = "EQUAL"
+      value    = "Active"
+    }
+  ]
+
+  sort_field = "Domain"
+  limit      = 500
+}
+
+# Publish the allowlist as a resource that stories can reference.
+resource "tines_resource" "sender_allowlist" {
+  team_id = 1
+  name    = "Sender allowlist"
+  value   = [for record in data.tines_records.allowlisted_domains.records : record["Domain"]]
+}
@@ -0,0 +1,18 @@
+data "tines_send_to_story_targets" "example" {
+  team_id = 1
+}
+
+locals {
+  story_export          = jsondecode(file("${path.module}/story-example.json"))
+  reachable_story_guids = data.tines_send_to_story_targets.example.stories[*].guid
+}
+
+# Fail the plan if the export calls a story that the target team cannot send to.
+check "send_to_story_targets_reachable" {
+  assert {
+    condition = alltrue([
+      for target in local.story_export.send_to_stories : contains(local.reachable_story_guids, target.guid)
+    ])
+    error_message = "The story export calls a Send to Story target that is not reachable from team 1."
+  }
+}
@@ -0,0 +1,13 @@
+# Resolve a list of email addresses to Tines user IDs.
+data "tines_users" "owners" {
+  emails = ["alice@example.com", "bob@example.com"]
+}
+
+# List every member of a Tines Team.
+data "tines_users" "team_members" {
+  team_id = 1
+}
+
+output "owner_ids" {
+  value = data.tines_users.owners.users[*].id
+}
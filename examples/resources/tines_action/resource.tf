@@ -0,0 +1,27 @@
+resource "tines_story" "example_story" {
+  team_id = 1
+  name    = "Example Story"
+}
+
+resource "tines_action" "example_http_request" {
+  story_id    = tines_story.example_story.id
+  type        = "Agents::HTTPRequestAgent"
+  name        = "Get users"
+  description = "Fetches the list of users from the directory."
+
+  options = {
+    url    = "https://api.example.com/v1/users"
+    method = "get"
+    headers = {
+      Authorization = "Bearer <<CREDENTIAL.example_api_token>>"
+    }
+  }
+
+  position = {
+    x = 0
+    y = 0
+  }
+
+  monitor_failures          = true
+  monitor_no_events_emitted = 86400
+}
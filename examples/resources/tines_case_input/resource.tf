@@ -0,0 +1,17 @@
+# A case input restricted to a fixed list of severities.
+resource "tines_case_input" "example_severity" {
+  team_id            = 1
+  name               = "Severity"
+  input_type         = "string"
+  validation_type    = "options"
+  validation_options = ["Low", "Medium", "High", "Critical"]
+}
+
+# A case input that must contain a valid ticket reference.
+resource "tines_case_input" "example_ticket" {
+  team_id          = 1
+  name             = "Ticket"
+  input_type       = "string"
+  validation_type  = "regex"
+  validation_regex = "^SEC-[0-9]+$"
+}
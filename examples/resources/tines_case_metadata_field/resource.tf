@@ -0,0 +1,17 @@
+# A metadata field that records the ID of the alert that opened the case.
+resource "tines_case_metadata_field" "example_alert_id" {
+  team_id          = 1
+  name             = "Alert ID"
+  field_type       = "string"
+  validation_type  = "regex"
+  validation_regex = "^ALERT-[0-9]+$"
+}
+
+# A metadata field restricted to the tenants monitored by the team.
+resource "tines_case_metadata_field" "example_tenant" {
+  team_id            = 1
+  name               = "Tenant"
+  field_type         = "string"
+  validation_type    = "options"
+  validation_options = ["emea", "amer", "apac"]
+}
@@ -0,0 +1,2 @@
+# Change requests can be imported using the story ID and change request ID, separated by a colon.
+terraform import tines_change_request.example_release 1:123
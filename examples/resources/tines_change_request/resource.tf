@@ -0,0 +1,8 @@
+# Submit the pending changes of a change-controlled story for review, and promote them once approved.
+resource "tines_change_request" "example_release" {
+  story_id          = 1
+  title             = "Update alert triage thresholds"
+  description       = "Raises the severity threshold used when triaging alerts."
+  wait_for_approval = true
+  approval_timeout  = "2h"
+}
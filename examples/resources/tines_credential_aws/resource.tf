@@ -0,0 +1,41 @@
+variable "aws_access_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "aws_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "tines_credential_aws" "example_keys" {
+  name                = "aws_keys"
+  team_id             = 1
+  authentication_type = "KEY"
+  access_key          = var.aws_access_key
+  secret_key          = var.aws_secret_key
+  keys_version        = 1
+}
+
+# Create the IAM role and the Tines Credential in the same apply.
+resource "aws_iam_role" "tines" {
+  name = "tines-automation"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "sts:AssumeRole"
+      Principal = { AWS = "arn:aws:iam::123456789012:root" }
+      Condition = { StringEquals = { "sts:ExternalId" = "tines-example" } }
+    }]
+  })
+}
+
+resource "tines_credential_aws" "example_role" {
+  name                = "aws_role"
+  team_id             = 1
+  authentication_type = "ROLE"
+  role_arn            = aws_iam_role.tines.arn
+  external_id         = "tines-example"
+  session_duration    = 900
+}
@@ -0,0 +1,38 @@
+variable "vendor_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "tines_credential_http_request" "example_session_token" {
+  name    = "vendor_session_token"
+  team_id = 1
+
+  steps = [
+    {
+      url    = "https://api.example.com/v1/login"
+      method = "POST"
+      headers = {
+        "Content-Type" = "application/json"
+      }
+      body = {
+        username = "automation"
+        password = "<<INPUTS.password>>"
+      }
+    },
+    {
+      url    = "https://api.example.com/v1/session"
+      method = "GET"
+      headers = {
+        Authorization = "Bearer <<previous_request.body.refresh_token>>"
+      }
+    },
+  ]
+
+  token_formula = "<<response.body.access_token>>"
+
+  inputs = {
+    password = var.vendor_password
+  }
+  inputs_version = 1
+  allowed_hosts  = ["api.example.com"]
+}
@@ -0,0 +1,17 @@
+variable "google_service_account_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "tines_credential_jwt" "example_google_service_account" {
+  name      = "google_service_account"
+  team_id   = 1
+  algorithm = "RS256"
+  payload = {
+    iss   = "automation@example-project.iam.gserviceaccount.com"
+    scope = "https://www.googleapis.com/auth/admin.directory.user.readonly"
+    aud   = "https://oauth2.googleapis.com/token"
+  }
+  private_key         = var.google_service_account_key
+  private_key_version = 1
+}
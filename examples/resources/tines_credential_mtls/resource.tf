@@ -0,0 +1,12 @@
+resource "tines_credential_mtls" "example_internal_api" {
+  name                = "internal_api_client_certificate"
+  team_id             = 1
+  certificate         = file("${path.module}/client.crt")
+  private_key         = file("${path.module}/client.key")
+  private_key_version = 1
+  ca_certificate      = file("${path.module}/ca.crt")
+  allowed_hosts       = ["internal.example.com"]
+
+  # Warn at plan time when the certificate expires within 60 days.
+  expiry_warning_days = 60
+}
@@ -0,0 +1,28 @@
+variable "okta_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "tines_credential_oauth" "example_client_credentials" {
+  name                  = "okta_api"
+  team_id               = 1
+  grant_type            = "client_credentials"
+  token_url             = "https://example.okta.com/oauth2/v1/token"
+  scopes                = ["okta.users.read", "okta.groups.read"]
+  client_id             = "0oa1example"
+  client_secret         = var.okta_client_secret
+  client_secret_version = 1
+  allowed_hosts         = ["example.okta.com"]
+}
+
+# The authorization code grant must be connected by a user in the Tines UI after creation.
+resource "tines_credential_oauth" "example_authorization_code" {
+  name              = "google_drive"
+  team_id           = 1
+  grant_type        = "authorization_code"
+  authorization_url = "https://accounts.google.com/o/oauth2/v2/auth"
+  token_url         = "https://oauth2.googleapis.com/token"
+  scopes            = ["https://www.googleapis.com/auth/drive.readonly"]
+  client_id         = "1234567890.apps.googleusercontent.com"
+  pkce_enabled      = true
+}
@@ -0,0 +1,38 @@
+variable "github_api_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "tines_credential_text" "example_text_credential" {
+  name          = "github_api_token"
+  team_id       = 1
+  value         = var.github_api_token
+  value_version = 1
+  allowed_hosts = ["api.github.com"]
+}
+
+# Share a credential with specific teams.
+resource "tines_credential_text" "example_shared_text_credential" {
+  name              = "shared_api_token"
+  team_id           = 1
+  value             = var.github_api_token
+  read_access       = "SPECIFIC_TEAMS"
+  shared_team_slugs = ["security_team"]
+}
+
+# Use a sandbox token when stories run in test mode.
+variable "github_sandbox_api_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "tines_credential_text" "example_text_credential_with_test_value" {
+  name                    = "github_api_token_with_test"
+  team_id                 = 1
+  value                   = var.github_api_token
+  test_credential_enabled = true
+  test_value = {
+    value = var.github_sandbox_api_token
+  }
+  test_value_version = 1
+}
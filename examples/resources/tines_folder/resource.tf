@@ -0,0 +1,11 @@
+resource "tines_folder" "example_story_folder" {
+  team_id      = 1
+  name         = "Example Stories"
+  content_type = "STORY"
+}
+
+resource "tines_story" "example_story" {
+  team_id   = 1
+  folder_id = tines_folder.example_story_folder.id
+  name      = "Example Story Name"
+}
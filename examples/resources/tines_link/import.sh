@@ -0,0 +1,2 @@
+# Links can be imported using the source action ID and receiver action ID, separated by a colon.
+terraform import tines_link.example_link 123:456
@@ -0,0 +1,40 @@
+resource "tines_story" "example_story" {
+  team_id = 1
+  name    = "Example Story"
+}
+
+resource "tines_action" "example_webhook" {
+  story_id = tines_story.example_story.id
+  type     = "Agents::WebhookAgent"
+  name     = "Receive alert"
+  options = {
+    path   = "receive-alert"
+    secret = "example-secret"
+    verbs  = "post"
+  }
+  position = {
+    x = 0
+    y = 0
+  }
+}
+
+resource "tines_action" "example_transform" {
+  story_id = tines_story.example_story.id
+  type     = "Agents::EventTransformationAgent"
+  name     = "Extract severity"
+  options = {
+    mode = "message_only"
+    payload = {
+      severity = "<<receive_alert.body.severity>>"
+    }
+  }
+  position = {
+    x = 0
+    y = 90
+  }
+}
+
+resource "tines_link" "example_link" {
+  source_action_id   = tines_action.example_webhook.id
+  receiver_action_id = tines_action.example_transform.id
+}
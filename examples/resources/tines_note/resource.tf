@@ -0,0 +1,21 @@
+variable "managed_story_ids" {
+  type = set(number)
+}
+
+# Add the same ownership note to every managed story.
+resource "tines_note" "example_ownership_note" {
+  for_each = var.managed_story_ids
+
+  story_id = each.value
+  content  = <<-EOT
+    **Managed by Terraform, do not edit.**
+
+    Owner: Security Engineering. Runbook: https://wiki.example.com/runbooks/tines
+  EOT
+  position = {
+    x = -300
+    y = 0
+  }
+  width = 280
+  color = "gold"
+}
@@ -0,0 +1,29 @@
+resource "tines_page" "example_intake_form" {
+  story_id = 1
+  name     = "Security intake form"
+
+  elements = [
+    {
+      type    = "HEADING"
+      content = "Request a security review"
+    },
+    {
+      type     = "SHORT_TEXT"
+      name     = "project_name"
+      label    = "Project name"
+      required = true
+    },
+    {
+      type  = "LONG_TEXT"
+      name  = "details"
+      label = "What do you need reviewed?"
+    },
+  ]
+
+  access_level    = "TENANT"
+  allowed_domains = ["example.com"]
+}
+
+output "intake_form_url" {
+  value = tines_page.example_intake_form.url
+}
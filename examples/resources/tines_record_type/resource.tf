@@ -0,0 +1,21 @@
+# A record type for the verdicts written by a phishing triage story.
+resource "tines_record_type" "example_phishing_verdict" {
+  team_id = 1
+  name    = "Phishing verdict"
+
+  field {
+    name = "Sender"
+    type = "TEXT"
+  }
+
+  field {
+    name    = "Verdict"
+    type    = "OPTIONS"
+    options = ["Malicious", "Suspicious", "Benign"]
+  }
+
+  field {
+    name = "Reported at"
+    type = "TIMESTAMP"
+  }
+}
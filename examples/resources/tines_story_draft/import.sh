@@ -0,0 +1,2 @@
+# Story drafts can be imported using the story ID and draft ID, separated by a colon.
+terraform import tines_story_draft.example_release_draft 1:123
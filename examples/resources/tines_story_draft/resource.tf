@@ -0,0 +1,13 @@
+# Apply a story export to a draft, so the changes can be reviewed before they are published.
+resource "tines_story_draft" "example_release_draft" {
+  story_id = 1
+  name     = "Release candidate"
+  data     = file("${path.module}/../tines_story/story-example.json")
+}
+
+# Submit the draft for approval.
+resource "tines_change_request" "example_release" {
+  story_id = tines_story_draft.example_release_draft.story_id
+  draft_id = tines_story_draft.example_release_draft.draft_id
+  title    = "Release candidate"
+}
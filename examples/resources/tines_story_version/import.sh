@@ -0,0 +1,2 @@
+# Story versions can be imported using the story ID and version ID, separated by a colon.
+terraform import tines_story_version.example_pre_release_snapshot 1:123
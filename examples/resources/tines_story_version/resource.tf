@@ -0,0 +1,9 @@
+# Save a named version of the story before every change to its export.
+resource "tines_story_version" "example_pre_release_snapshot" {
+  story_id = 1
+  name     = "Before release ${filesha256("${path.module}/story-example.json")}"
+
+  triggers = {
+    export_hash = filesha256("${path.module}/story-example.json")
+  }
+}
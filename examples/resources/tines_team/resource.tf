@@ -0,0 +1,10 @@
+resource "tines_team" "example_team" {
+  name            = "Example Team"
+  keep_events_for = 604800
+}
+
+# Other resources can then be created inside the new team.
+resource "tines_story" "example_story" {
+  team_id = tines_team.example_team.id
+  name    = "Example Story Name"
+}
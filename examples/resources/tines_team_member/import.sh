@@ -0,0 +1,2 @@
+# Team members can be imported using the team ID and user ID, separated by a colon.
+terraform import tines_team_member.example_editor 1:123
@@ -0,0 +1,13 @@
+# Add a user to a team by email address.
+resource "tines_team_member" "example_editor" {
+  team_id = 1
+  email   = "alice@example.com"
+  role    = "EDITOR"
+}
+
+# Add a user to a team by user ID.
+resource "tines_team_member" "example_viewer" {
+  team_id = 1
+  user_id = 123
+  role    = "VIEWER"
+}
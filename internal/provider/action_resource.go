@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+	"github.com/tines/terraform-provider-tines/internal/utils"
+)
+
+// actionResource is the resource implementation.
+type actionResource struct {
+	client *tines.Client
+}
+
+type actionResourceModel struct {
+	ID                     types.Int64          `tfsdk:"id"`
+	StoryID                types.Int64          `tfsdk:"story_id"`
+	Type                   types.String         `tfsdk:"type"`
+	Name                   types.String         `tfsdk:"name"`
+	Description            types.String         `tfsdk:"description"`
+	Disabled               types.Bool           `tfsdk:"disabled"`
+	Options                types.Dynamic        `tfsdk:"options"`
+	Position               *actionPositionModel `tfsdk:"position"`
+	MonitorFailures        types.Bool           `tfsdk:"monitor_failures"`
+	MonitorAllEvents       types.Bool           `tfsdk:"monitor_all_events"`
+	MonitorNoEventsEmitted types.Int64          `tfsdk:"monitor_no_events_emitted"`
+	Guid                   types.String         `tfsdk:"guid"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &actionResource{}
+	_ resource.ResourceWithConfigure   = &actionResource{}
+	_ resource.ResourceWithImportState = &actionResource{}
+)
+
+// NewActionResource is a helper function to simplify the provider implementation.
+func NewActionResource() resource.Resource {
+	return &actionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *actionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+const ACTION_RESOURCE_DESCRIPTION = `
+A Tines Action is a single step inside a Tines Story. Managing actions individually allows each one to be reviewed and
+changed on its own, instead of re-importing a full story export. Actions should not be managed in a story that is also
+managed through the data attribute of a tines_story resource, since importing the export replaces every action.`
+
+// Schema defines the schema for the resource.
+func (r *actionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: ACTION_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this action.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story that this action belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the action (Agents::EmailAgent, Agents::EventTransformationAgent, Agents::HTTPRequestAgent, Agents::IMAPAgent, Agents::LLMAgent, Agents::SendToStoryAgent, Agents::TriggerAgent, Agents::WebhookAgent).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"Agents::EmailAgent",
+						"Agents::EventTransformationAgent",
+						"Agents::HTTPRequestAgent",
+						"Agents::IMAPAgent",
+						"Agents::LLMAgent",
+						"Agents::SendToStoryAgent",
+						"Agents::TriggerAgent",
+						"Agents::WebhookAgent",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the action.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A long-form description of the action.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Boolean flag indicating whether the action is disabled. default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"options": schema.DynamicAttribute{
+				Description: "The configuration of the action as an object. The available options depend on the type of the action.",
+				Required:    true,
+			},
+			"position": schema.SingleNestedAttribute{
+				Description: "The position of the action on the storyboard.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"x": schema.Int64Attribute{
+						Description: "The horizontal position of the action.",
+						Required:    true,
+					},
+					"y": schema.Int64Attribute{
+						Description: "The vertical position of the action.",
+						Required:    true,
+					},
+				},
+			},
+			"monitor_failures": schema.BoolAttribute{
+				Description: "Boolean flag indicating whether a notification is sent when the action fails. default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"monitor_all_events": schema.BoolAttribute{
+				Description: "Boolean flag indicating whether a notification is sent for every event emitted by the action. default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"monitor_no_events_emitted": schema.Int64Attribute{
+				Description: "Send a notification when the action has not emitted an event for this many seconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"guid": schema.StringAttribute{
+				Description: "The globally unique identifier of the action.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates a new Tines Action and sets the initial Terraform state.
+func (r *actionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Action")
+
+	var plan actionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newAction := tines.Action{
+		StoryID: int(plan.StoryID.ValueInt64()),
+		Type:    plan.Type.ValueString(),
+	}
+
+	diags = r.convertPlanToAction(ctx, &plan, &newAction)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, err := r.client.CreateAction(ctx, &newAction)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Action",
+			"Could not create action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertActionToPlan(ctx, &plan, action)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *actionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState actionResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetAction(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := r.convertActionToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Action and sets the updated Terraform state on success.
+func (r *actionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Action")
+
+	var plan actionResourceModel
+	var actionUpdate tines.Action
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToAction(ctx, &plan, &actionUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, err := r.client.UpdateAction(ctx, int(plan.ID.ValueInt64()), &actionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Action",
+			"Could not update action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertActionToPlan(ctx, &plan, action)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Action and removes the Terraform state on success.
+func (r *actionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Action")
+
+	// Retrieve values from state
+	var state actionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing action.
+	err := r.client.DeleteAction(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Action",
+			"Could not delete action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *actionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Action")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *actionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Sets the attributes of an API request body. The story and type of an action can't be
+// changed after it is created, so they are set separately in Create.
+func (r *actionResource) convertPlanToAction(ctx context.Context, plan *actionResourceModel, action *tines.Action) (diags diag.Diagnostics) {
+	action.Name = plan.Name.ValueString()
+	action.Description = plan.Description.ValueString()
+	action.Disabled = plan.Disabled.ValueBool()
+	action.MonitorFailures = plan.MonitorFailures.ValueBool()
+	action.MonitorAllEvents = plan.MonitorAllEvents.ValueBool()
+	action.MonitorNoEventsEmitted = int(plan.MonitorNoEventsEmitted.ValueInt64())
+	action.Position = tines.Position{
+		X: int(plan.Position.X.ValueInt64()),
+		Y: int(plan.Position.Y.ValueInt64()),
+	}
+	action.Options, diags = utils.GetUnderlyingDynamicValue(ctx, &plan.Options)
+
+	return diags
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *actionResource) convertActionToPlan(ctx context.Context, plan *actionResourceModel, action *tines.Action) (diags diag.Diagnostics) {
+	plan.ID = types.Int64Value(int64(action.ID))
+	plan.StoryID = types.Int64Value(int64(action.StoryID))
+	plan.Type = types.StringValue(action.Type)
+	plan.Name = types.StringValue(action.Name)
+	plan.Description = types.StringValue(action.Description)
+	plan.Disabled = types.BoolValue(action.Disabled)
+	plan.MonitorFailures = types.BoolValue(action.MonitorFailures)
+	plan.MonitorAllEvents = types.BoolValue(action.MonitorAllEvents)
+	if action.MonitorNoEventsEmitted != 0 {
+		plan.MonitorNoEventsEmitted = types.Int64Value(int64(action.MonitorNoEventsEmitted))
+	} else {
+		plan.MonitorNoEventsEmitted = types.Int64Null()
+	}
+	plan.Position = &actionPositionModel{
+		X: types.Int64Value(int64(action.Position.X)),
+		Y: types.Int64Value(int64(action.Position.Y)),
+	}
+	plan.Guid = types.StringValue(action.Guid)
+
+	plan.Options, diags = utils.DynamicValueFromAny(ctx, action.Options)
+
+	return diags
+}
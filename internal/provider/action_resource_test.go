@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesAction_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the action.
+				Config: providerConfig + testAccCreateTinesAction("https://example.com/v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_action.test_action",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_action.test_action",
+						tfjsonpath.New("options").AtMapKey("url"),
+						knownvalue.StringExact("https://example.com/v1"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_action.test_action",
+						tfjsonpath.New("position").AtMapKey("x"),
+						knownvalue.Int64Exact(150),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_action.test_action",
+						tfjsonpath.New("disabled"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				// Update the options in place.
+				Config: providerConfig + testAccCreateTinesAction("https://example.com/v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_action.test_action", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_action.test_action",
+						tfjsonpath.New("options").AtMapKey("url"),
+						knownvalue.StringExact("https://example.com/v2"),
+					),
+				},
+			},
+			{
+				// Import the existing action.
+				ResourceName:      "tines_action.test_action",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCreateTinesAction(url string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_action_story" {
+	team_id = 30906
+	name = "Terraform Test Action Story"
+}
+
+resource "tines_action" "test_action" {
+	story_id = tines_story.test_action_story.id
+	type = "Agents::HTTPRequestAgent"
+	name = "Terraform Test Action"
+	options = {
+		url = %q
+		method = "get"
+	}
+	position = {
+		x = 150
+		y = 300
+	}
+	monitor_failures = true
+}
+	`, url)
+}
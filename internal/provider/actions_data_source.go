@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// actionsDataSource is the data source implementation.
+type actionsDataSource struct {
+	client *tines.Client
+}
+
+type actionsDataSourceModel struct {
+	StoryID types.Int64       `tfsdk:"story_id"`
+	Actions []actionItemModel `tfsdk:"actions"`
+}
+
+type actionItemModel struct {
+	ID          types.Int64          `tfsdk:"id"`
+	Guid        types.String         `tfsdk:"guid"`
+	Name        types.String         `tfsdk:"name"`
+	Type        types.String         `tfsdk:"type"`
+	Description types.String         `tfsdk:"description"`
+	Disabled    types.Bool           `tfsdk:"disabled"`
+	Position    *actionPositionModel `tfsdk:"position"`
+}
+
+type actionPositionModel struct {
+	X types.Int64 `tfsdk:"x"`
+	Y types.Int64 `tfsdk:"y"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &actionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &actionsDataSource{}
+)
+
+// NewActionsDataSource is a helper function to simplify the provider implementation.
+func NewActionsDataSource() datasource.DataSource {
+	return &actionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *actionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions"
+}
+
+const ACTIONS_DATA_SOURCE_DESCRIPTION = `
+Use this data source to list the actions inside a Tines Story. Action IDs change every time a story is imported into a
+new tenant, so this allows attributes such as entry_agent_id and exit_agents on a tines_story resource to be selected by
+action name instead.`
+
+// Schema defines the schema for the data source.
+func (d *actionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: ACTIONS_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story to list actions for.",
+				Required:    true,
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The list of actions in the story.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The Tines-generated identifier for this action.",
+							Computed:    true,
+						},
+						"guid": schema.StringAttribute{
+							Description: "The globally unique identifier of the action.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the action.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the action (e.g. Agents::WebhookAgent, Agents::HTTPRequestAgent, Agents::EventTransformationAgent).",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A user-defined description of the action.",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Boolean flag indicating whether the action is disabled from running.",
+							Computed:    true,
+						},
+						"position": schema.SingleNestedAttribute{
+							Description: "The position of the action on the storyboard.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"x": schema.Int64Attribute{
+									Description: "The horizontal position of the action on the storyboard.",
+									Computed:    true,
+								},
+								"y": schema.Int64Attribute{
+									Description: "The vertical position of the action on the storyboard.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *actionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Actions")
+
+	var state actionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions, err := d.client.ListActions(ctx, int(state.StoryID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tines Actions",
+			"An unexpected error occurred while attempting to list the actions in the story. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Actions = []actionItemModel{}
+	for _, action := range actions {
+		state.Actions = append(state.Actions, actionItemModel{
+			ID:          types.Int64Value(int64(action.ID)),
+			Guid:        types.StringValue(action.Guid),
+			Name:        types.StringValue(action.Name),
+			Type:        types.StringValue(action.Type),
+			Description: types.StringValue(action.Description),
+			Disabled:    types.BoolValue(action.Disabled),
+			Position: &actionPositionModel{
+				X: types.Int64Value(int64(action.Position.X)),
+				Y: types.Int64Value(int64(action.Position.Y)),
+			},
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *actionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesActionsDataSource_fromExport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccTinesActionsDataSourceFromExport(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tines_actions.test_actions",
+						tfjsonpath.New("actions"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.tines_actions.test_actions",
+						tfjsonpath.New("actions").AtSliceIndex(0).AtMapKey("id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTinesActionsDataSourceFromExport() string {
+	return `
+resource "tines_story" "test_actions_story" {
+	data = file("${path.module}/testdata/test-story.json")
+	team_id = 30906
+}
+
+data "tines_actions" "test_actions" {
+	story_id = tines_story.test_actions_story.id
+}
+	`
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// auditLogsDataSource is the data source implementation.
+type auditLogsDataSource struct {
+	client *tines.Client
+}
+
+type auditLogsDataSourceModel struct {
+	After          types.String        `tfsdk:"after"`
+	Before         types.String        `tfsdk:"before"`
+	UserIDs        types.List          `tfsdk:"user_ids"`
+	OperationNames types.List          `tfsdk:"operation_names"`
+	TargetType     types.String        `tfsdk:"target_type"`
+	TargetID       types.Int64         `tfsdk:"target_id"`
+	AuditLogs      []auditLogItemModel `tfsdk:"audit_logs"`
+}
+
+type auditLogItemModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UserID           types.Int64  `tfsdk:"user_id"`
+	UserEmail        types.String `tfsdk:"user_email"`
+	UserName         types.String `tfsdk:"user_name"`
+	OperationName    types.String `tfsdk:"operation_name"`
+	TargetType       types.String `tfsdk:"target_type"`
+	TargetID         types.Int64  `tfsdk:"target_id"`
+	RequestIP        types.String `tfsdk:"request_ip"`
+	RequestUserAgent types.String `tfsdk:"request_user_agent"`
+	Inputs           types.String `tfsdk:"inputs"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &auditLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditLogsDataSource{}
+)
+
+// NewAuditLogsDataSource is a helper function to simplify the provider implementation.
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &auditLogsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *auditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+const AUDIT_LOGS_DATA_SOURCE_DESCRIPTION = `
+Use this data source to query the Tines tenant audit log. Entries can be filtered by time window, user, operation name
+and target object, which allows drift and compliance checks (for example, that no managed story was edited by hand
+since the last deployment) to be expressed as Terraform check blocks. Reading audit logs requires a tenant admin API key.`
+
+// Schema defines the schema for the data source.
+func (d *auditLogsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: AUDIT_LOGS_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"after": schema.StringAttribute{
+				Description: "Only return entries created after this RFC 3339 timestamp, e.g. timeadd(plantimestamp(), \"-24h\").",
+				Optional:    true,
+			},
+			"before": schema.StringAttribute{
+				Description: "Only return entries created before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"user_ids": schema.ListAttribute{
+				Description: "Only return entries for operations performed by these user IDs.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"operation_names": schema.ListAttribute{
+				Description: "Only return entries for these operation names (e.g. StoryUpdate, ActionsUpdate).",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"target_type": schema.StringAttribute{
+				Description: "Only return entries for objects of this type (e.g. Story, Agent, UserCredential). Required to set target_id.",
+				Optional:    true,
+			},
+			"target_id": schema.Int64Attribute{
+				Description: "Only return entries for the object with this ID.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("target_type")),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				Description: "The list of matching audit log entries, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The Tines-generated identifier for this audit log entry.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "ISO 8601 Timestamp representing date and time the operation was performed.",
+							Computed:    true,
+						},
+						"user_id": schema.Int64Attribute{
+							Description: "The ID of the user that performed the operation.",
+							Computed:    true,
+						},
+						"user_email": schema.StringAttribute{
+							Description: "The email address of the user that performed the operation.",
+							Computed:    true,
+						},
+						"user_name": schema.StringAttribute{
+							Description: "The name of the user that performed the operation.",
+							Computed:    true,
+						},
+						"operation_name": schema.StringAttribute{
+							Description: "The name of the operation that was performed.",
+							Computed:    true,
+						},
+						"target_type": schema.StringAttribute{
+							Description: "The type of the object the operation was performed on.",
+							Computed:    true,
+						},
+						"target_id": schema.Int64Attribute{
+							Description: "The ID of the object the operation was performed on.",
+							Computed:    true,
+						},
+						"request_ip": schema.StringAttribute{
+							Description: "The IP address the operation was requested from.",
+							Computed:    true,
+						},
+						"request_user_agent": schema.StringAttribute{
+							Description: "The user agent the operation was requested with.",
+							Computed:    true,
+						},
+						"inputs": schema.StringAttribute{
+							Description: "The inputs of the operation as a JSON string. Use jsondecode() to access individual values.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *auditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Audit Logs")
+
+	var state auditLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var query tines.AuditLogQuery
+
+	// Validate the time window here rather than with a schema validator, as the values are
+	// frequently computed with functions such as plantimestamp() and are unknown at validation.
+	if !state.After.IsNull() {
+		after, err := time.Parse(time.RFC3339, state.After.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("after"),
+				"Invalid Timestamp",
+				"The after attribute must be a valid RFC 3339 timestamp: "+err.Error(),
+			)
+		}
+		query.After = after
+	}
+
+	if !state.Before.IsNull() {
+		before, err := time.Parse(time.RFC3339, state.Before.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("before"),
+				"Invalid Timestamp",
+				"The before attribute must be a valid RFC 3339 timestamp: "+err.Error(),
+			)
+		}
+		query.Before = before
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.UserIDs.IsNull() {
+		resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &query.UserIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !state.OperationNames.IsNull() {
+		resp.Diagnostics.Append(state.OperationNames.ElementsAs(ctx, &query.OperationNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !state.TargetType.IsNull() {
+		query.TargetType = state.TargetType.ValueString()
+	}
+
+	if !state.TargetID.IsNull() {
+		query.TargetID = int(state.TargetID.ValueInt64())
+	}
+
+	auditLogs, err := d.client.ListAuditLogs(ctx, &query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tines Audit Logs",
+			"An unexpected error occurred while attempting to list audit logs. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	state.AuditLogs = []auditLogItemModel{}
+	for _, auditLog := range auditLogs {
+		inputs, err := json.Marshal(auditLog.Inputs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tines Audit Logs",
+				fmt.Sprintf("Could not encode the inputs of audit log entry %d, unexpected error: %s", auditLog.ID, err.Error()),
+			)
+			return
+		}
+
+		state.AuditLogs = append(state.AuditLogs, auditLogItemModel{
+			ID:               types.Int64Value(int64(auditLog.ID)),
+			CreatedAt:        types.StringValue(auditLog.CreatedAt),
+			UserID:           types.Int64Value(int64(auditLog.UserID)),
+			UserEmail:        types.StringValue(auditLog.UserEmail),
+			UserName:         types.StringValue(auditLog.UserName),
+			OperationName:    types.StringValue(auditLog.OperationName),
+			TargetType:       types.StringValue(auditLog.TargetType),
+			TargetID:         types.Int64Value(int64(auditLog.TargetID)),
+			RequestIP:        types.StringValue(auditLog.RequestIP),
+			RequestUserAgent: types.StringValue(auditLog.RequestUserAgent),
+			Inputs:           types.StringValue(string(inputs)),
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *auditLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
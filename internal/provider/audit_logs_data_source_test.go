@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesAuditLogsDataSource_timeWindow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccTinesAuditLogsDataSourceBadTimestamp(),
+				ExpectError: regexp.MustCompile("Invalid Timestamp"),
+			},
+			{
+				Config: providerConfig + testAccTinesAuditLogsDataSourceTimeWindow(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tines_audit_logs.test_recent",
+						tfjsonpath.New("audit_logs"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTinesAuditLogsDataSourceBadTimestamp() string {
+	return `
+data "tines_audit_logs" "test_bad_timestamp" {
+	after = "yesterday"
+}
+	`
+}
+
+func testAccTinesAuditLogsDataSourceTimeWindow() string {
+	return `
+data "tines_audit_logs" "test_recent" {
+	after = timeadd(plantimestamp(), "-24h")
+	operation_names = ["StoryUpdate"]
+}
+	`
+}
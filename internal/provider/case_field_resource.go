@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// caseFieldResource holds the behaviour shared by the tines_case_input and tines_case_metadata_field
+// resources. Both describe a custom field added to every case in a team, and only differ in the
+// API endpoints they use and the name of their type attribute.
+type caseFieldResource struct {
+	client *tines.Client
+}
+
+// caseFieldResourceModel describes the attributes shared by the case field resources.
+// It is embedded in the model of each resource.
+type caseFieldResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	TeamID            types.Int64  `tfsdk:"team_id"`
+	Name              types.String `tfsdk:"name"`
+	Key               types.String `tfsdk:"key"`
+	ValidationType    types.String `tfsdk:"validation_type"`
+	ValidationRegex   types.String `tfsdk:"validation_regex"`
+	ValidationOptions types.List   `tfsdk:"validation_options"`
+}
+
+// caseFieldResourceAttributes returns the schema attributes shared by the case field resources.
+// A new map is returned on each call so that callers can add their own type attribute.
+// noun is the name of the resource used in the attribute descriptions, such as "case input".
+func caseFieldResourceAttributes(noun string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: fmt.Sprintf("The Tines-generated identifier for this %s.", noun),
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"team_id": schema.Int64Attribute{
+			Description: fmt.Sprintf("The ID of the Tines Team that the %s belongs to.", noun),
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("The name of the %s, as shown on cases.", noun),
+			Required:    true,
+		},
+		"key": schema.StringAttribute{
+			Description: fmt.Sprintf("The key used to reference the %s in stories.", noun),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"validation_type": schema.StringAttribute{
+			Description: fmt.Sprintf("How values of the %s are validated (none, regex, options). default: none.", noun),
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("none"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("none", "regex", "options"),
+			},
+		},
+		"validation_regex": schema.StringAttribute{
+			Description: "The regular expression that values must match. Required when validation_type is regex.",
+			Optional:    true,
+		},
+		"validation_options": schema.ListAttribute{
+			Description: "The list of values that can be selected. Required when validation_type is options.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+	}
+}
+
+// caseFieldTypeAttribute returns the attribute that sets the type of value stored in a case field.
+func caseFieldTypeAttribute(noun string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The type of value stored in the %s (string, number, boolean, timestamp). Changing this forces a new %s to be created.", noun, noun),
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("string", "number", "boolean", "timestamp"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// ValidateConfig checks that the validation settings match the validation type.
+func (r *caseFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var validationType, validationRegex types.String
+	var validationOptions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validation_type"), &validationType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validation_regex"), &validationRegex)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validation_options"), &validationOptions)...)
+	if resp.Diagnostics.HasError() || validationType.IsUnknown() {
+		return
+	}
+
+	if validationType.ValueString() == "regex" && validationRegex.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validation_regex"),
+			"Missing Validation Regex",
+			"The validation_regex attribute must be set when validation_type is regex.",
+		)
+	}
+
+	if validationType.ValueString() != "regex" && !validationRegex.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validation_regex"),
+			"Invalid Validation Regex",
+			"The validation_regex attribute can only be set when validation_type is regex.",
+		)
+	}
+
+	if validationType.ValueString() == "options" && validationOptions.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validation_options"),
+			"Missing Validation Options",
+			"The validation_options attribute must be set when validation_type is options.",
+		)
+	}
+
+	if validationType.ValueString() != "options" && !validationOptions.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validation_options"),
+			"Invalid Validation Options",
+			"The validation_options attribute can only be set when validation_type is options.",
+		)
+	}
+}
+
+func (r *caseFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Case Field")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Case Field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *caseFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Sets the shared attributes of an API request body. The team of a case field can't be
+// changed after it is created, so it is set separately in Create.
+func convertPlanToCaseField(ctx context.Context, plan *caseFieldResourceModel, field *tines.CaseField) (diags diag.Diagnostics) {
+	field.Name = plan.Name.ValueString()
+	field.ValidationType = plan.ValidationType.ValueString()
+	field.ValidationRegex = plan.ValidationRegex.ValueString()
+
+	// Always send the list of options, so that changing the validation type clears it.
+	field.ValidationOptions = []string{}
+	if !plan.ValidationOptions.IsNull() && !plan.ValidationOptions.IsUnknown() {
+		diags = plan.ValidationOptions.ElementsAs(ctx, &field.ValidationOptions, false)
+	}
+
+	return diags
+}
+
+// This is reused in the Create, Read and Update methods of each case field resource.
+func convertCaseFieldToPlan(ctx context.Context, plan *caseFieldResourceModel, field *tines.CaseField) (diags diag.Diagnostics) {
+	plan.ID = types.Int64Value(int64(field.ID))
+	plan.TeamID = types.Int64Value(int64(field.TeamID))
+	plan.Name = types.StringValue(field.Name)
+	plan.Key = types.StringValue(field.Key)
+	plan.ValidationType = types.StringValue(field.ValidationType)
+
+	if field.ValidationRegex != "" {
+		plan.ValidationRegex = types.StringValue(field.ValidationRegex)
+	} else {
+		plan.ValidationRegex = types.StringNull()
+	}
+
+	if len(field.ValidationOptions) > 0 {
+		plan.ValidationOptions, diags = types.ListValueFrom(ctx, types.StringType, field.ValidationOptions)
+	} else {
+		plan.ValidationOptions = types.ListNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// caseInputResource is the resource implementation.
+type caseInputResource struct {
+	caseFieldResource
+}
+
+type caseInputResourceModel struct {
+	caseFieldResourceModel
+	InputType types.String `tfsdk:"input_type"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &caseInputResource{}
+	_ resource.ResourceWithConfigure      = &caseInputResource{}
+	_ resource.ResourceWithImportState    = &caseInputResource{}
+	_ resource.ResourceWithValidateConfig = &caseInputResource{}
+)
+
+// NewCaseInputResource is a helper function to simplify the provider implementation.
+func NewCaseInputResource() resource.Resource {
+	return &caseInputResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *caseInputResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_input"
+}
+
+const CASE_INPUT_RESOURCE_DESCRIPTION = `
+A Tines Case Input is a custom input that is added to every case in a team, such as a severity or an affected hostname.
+Values entered for a case input can be validated against a regular expression or restricted to a list of options.
+Destroying this resource removes the input, and the values recorded for it, from every case in the team.`
+
+// Schema defines the schema for the resource.
+func (r *caseInputResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := caseFieldResourceAttributes("case input")
+	attributes["input_type"] = caseFieldTypeAttribute("case input")
+
+	resp.Schema = schema.Schema{
+		Description: CASE_INPUT_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// Create creates a new Tines Case Input and sets the initial Terraform state.
+func (r *caseInputResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Case Input")
+
+	var plan caseInputResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCaseInput := tines.CaseInput{
+		CaseField: tines.CaseField{
+			TeamID: int(plan.TeamID.ValueInt64()),
+		},
+		InputType: plan.InputType.ValueString(),
+	}
+
+	diags = convertPlanToCaseField(ctx, &plan.caseFieldResourceModel, &newCaseInput.CaseField)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caseInput, err := r.client.CreateCaseInput(ctx, &newCaseInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Case Input",
+			"Could not create case input, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertCaseInputToPlan(ctx, &plan, caseInput)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *caseInputResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState caseInputResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetCaseInput(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := r.convertCaseInputToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the Tines Case Input in place and sets the updated Terraform state on success.
+func (r *caseInputResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Case Input")
+
+	var plan caseInputResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var caseInputUpdate tines.CaseInput
+	diags = convertPlanToCaseField(ctx, &plan.caseFieldResourceModel, &caseInputUpdate.CaseField)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caseInput, err := r.client.UpdateCaseInput(ctx, int(plan.ID.ValueInt64()), &caseInputUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Case Input",
+			"Could not update case input, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertCaseInputToPlan(ctx, &plan, caseInput)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Case Input and removes the Terraform state on success.
+func (r *caseInputResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Case Input")
+
+	// Retrieve values from state
+	var state caseInputResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCaseInput(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Case Input",
+			"Could not delete case input, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *caseInputResource) convertCaseInputToPlan(ctx context.Context, plan *caseInputResourceModel, caseInput *tines.CaseInput) diag.Diagnostics {
+	plan.InputType = types.StringValue(caseInput.InputType)
+
+	return convertCaseFieldToPlan(ctx, &plan.caseFieldResourceModel, &caseInput.CaseField)
+}
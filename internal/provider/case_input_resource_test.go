@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesCaseInput_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a case input restricted to a list of options.
+				Config: providerConfig + testAccCreateTinesCaseInput(`"Low", "High"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_case_input.test_case_input",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_case_input.test_case_input",
+						tfjsonpath.New("validation_options"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("Low"),
+							knownvalue.StringExact("High"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_case_input.test_case_input",
+						tfjsonpath.New("key"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				// Adding an option updates the case input in place.
+				Config: providerConfig + testAccCreateTinesCaseInput(`"Low", "Medium", "High"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_case_input.test_case_input", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Import the existing case input.
+				ResourceName:      "tines_case_input.test_case_input",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTinesCaseInput_missingRegex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tines_case_input" "test_case_input" {
+	team_id = 30906
+	name = "Terraform Test Case Input"
+	input_type = "string"
+	validation_type = "regex"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Validation Regex"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCaseInput(options string) string {
+	return fmt.Sprintf(`
+resource "tines_case_input" "test_case_input" {
+	team_id = 30906
+	name = "Terraform Test Case Input"
+	input_type = "string"
+	validation_type = "options"
+	validation_options = [%s]
+}
+`, options)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// caseMetadataFieldResource is the resource implementation.
+type caseMetadataFieldResource struct {
+	caseFieldResource
+}
+
+type caseMetadataFieldResourceModel struct {
+	caseFieldResourceModel
+	FieldType types.String `tfsdk:"field_type"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &caseMetadataFieldResource{}
+	_ resource.ResourceWithConfigure      = &caseMetadataFieldResource{}
+	_ resource.ResourceWithImportState    = &caseMetadataFieldResource{}
+	_ resource.ResourceWithValidateConfig = &caseMetadataFieldResource{}
+)
+
+// NewCaseMetadataFieldResource is a helper function to simplify the provider implementation.
+func NewCaseMetadataFieldResource() resource.Resource {
+	return &caseMetadataFieldResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *caseMetadataFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_metadata_field"
+}
+
+const CASE_METADATA_FIELD_RESOURCE_DESCRIPTION = `
+A Tines Case Metadata Field is a key/value field shown in the metadata of every case in a team, such as the source alert
+ID or the affected tenant. Unlike case inputs, metadata fields are typically set by stories rather than by analysts.
+Values can be validated against a regular expression or restricted to a list of options. Destroying this resource
+removes the field, and the values recorded for it, from every case in the team.`
+
+// Schema defines the schema for the resource.
+func (r *caseMetadataFieldResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := caseFieldResourceAttributes("case metadata field")
+	attributes["field_type"] = caseFieldTypeAttribute("case metadata field")
+
+	resp.Schema = schema.Schema{
+		Description: CASE_METADATA_FIELD_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// Create creates a new Tines Case Metadata Field and sets the initial Terraform state.
+func (r *caseMetadataFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Case Metadata Field")
+
+	var plan caseMetadataFieldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCaseMetadataField := tines.CaseMetadataField{
+		CaseField: tines.CaseField{
+			TeamID: int(plan.TeamID.ValueInt64()),
+		},
+		FieldType: plan.FieldType.ValueString(),
+	}
+
+	diags = convertPlanToCaseField(ctx, &plan.caseFieldResourceModel, &newCaseMetadataField.CaseField)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caseMetadataField, err := r.client.CreateCaseMetadataField(ctx, &newCaseMetadataField)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Case Metadata Field",
+			"Could not create case metadata field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertCaseMetadataFieldToPlan(ctx, &plan, caseMetadataField)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *caseMetadataFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState caseMetadataFieldResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetCaseMetadataField(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := r.convertCaseMetadataFieldToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the Tines Case Metadata Field in place and sets the updated Terraform state on success.
+func (r *caseMetadataFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Case Metadata Field")
+
+	var plan caseMetadataFieldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var caseMetadataFieldUpdate tines.CaseMetadataField
+	diags = convertPlanToCaseField(ctx, &plan.caseFieldResourceModel, &caseMetadataFieldUpdate.CaseField)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caseMetadataField, err := r.client.UpdateCaseMetadataField(ctx, int(plan.ID.ValueInt64()), &caseMetadataFieldUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Case Metadata Field",
+			"Could not update case metadata field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertCaseMetadataFieldToPlan(ctx, &plan, caseMetadataField)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Case Metadata Field and removes the Terraform state on success.
+func (r *caseMetadataFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Case Metadata Field")
+
+	// Retrieve values from state
+	var state caseMetadataFieldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCaseMetadataField(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Case Metadata Field",
+			"Could not delete case metadata field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *caseMetadataFieldResource) convertCaseMetadataFieldToPlan(ctx context.Context, plan *caseMetadataFieldResourceModel, caseMetadataField *tines.CaseMetadataField) diag.Diagnostics {
+	plan.FieldType = types.StringValue(caseMetadataField.FieldType)
+
+	return convertCaseFieldToPlan(ctx, &plan.caseFieldResourceModel, &caseMetadataField.CaseField)
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesCaseMetadataField_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a metadata field validated by a regular expression.
+				Config: providerConfig + testAccCreateTinesCaseMetadataField(`
+	validation_type = "regex"
+	validation_regex = "^ALERT-[0-9]+$"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_case_metadata_field.test_case_metadata_field",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_case_metadata_field.test_case_metadata_field",
+						tfjsonpath.New("field_type"),
+						knownvalue.StringExact("string"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_case_metadata_field.test_case_metadata_field",
+						tfjsonpath.New("validation_regex"),
+						knownvalue.StringExact("^ALERT-[0-9]+$"),
+					),
+				},
+			},
+			{
+				// Switching to a list of options clears the regular expression.
+				Config: providerConfig + testAccCreateTinesCaseMetadataField(`
+	validation_type = "options"
+	validation_options = ["emea", "amer"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_case_metadata_field.test_case_metadata_field", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_case_metadata_field.test_case_metadata_field",
+						tfjsonpath.New("validation_regex"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Import the existing metadata field.
+				ResourceName:      "tines_case_metadata_field.test_case_metadata_field",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCaseMetadataField(validation string) string {
+	return fmt.Sprintf(`
+resource "tines_case_metadata_field" "test_case_metadata_field" {
+	team_id = 30906
+	name = "Terraform Test Case Metadata Field"
+	field_type = "string"%s
+}
+`, validation)
+}
@@ -0,0 +1,493 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// How often the status of a change request is checked while waiting for approval.
+const changeRequestPollInterval = 15 * time.Second
+
+// Matches the durations accepted by time.ParseDuration, such as 30m or 1h30m.
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`)
+
+// changeRequestResource is the resource implementation.
+type changeRequestResource struct {
+	client *tines.Client
+}
+
+type changeRequestResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	StoryID         types.Int64  `tfsdk:"story_id"`
+	DraftID         types.Int64  `tfsdk:"draft_id"`
+	Title           types.String `tfsdk:"title"`
+	Description     types.String `tfsdk:"description"`
+	WaitForApproval types.Bool   `tfsdk:"wait_for_approval"`
+	ApprovalTimeout types.String `tfsdk:"approval_timeout"`
+	ChangeRequestID types.Int64  `tfsdk:"change_request_id"`
+	Status          types.String `tfsdk:"status"`
+	Approvers       types.List   `tfsdk:"approvers"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &changeRequestResource{}
+	_ resource.ResourceWithConfigure   = &changeRequestResource{}
+	_ resource.ResourceWithImportState = &changeRequestResource{}
+	_ resource.ResourceWithModifyPlan  = &changeRequestResource{}
+)
+
+// NewChangeRequestResource is a helper function to simplify the provider implementation.
+func NewChangeRequestResource() resource.Resource {
+	return &changeRequestResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *changeRequestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_change_request"
+}
+
+const CHANGE_REQUEST_RESOURCE_DESCRIPTION = `
+A Tines Change Request submits the pending changes of a story with change control enabled for approval. When
+wait_for_approval is set, Terraform waits until the change request is approved and then promotes the changes to the
+live story. If it isn't approved within approval_timeout, a warning is shown and the next apply waits again. Otherwise,
+the change request is left open for reviewers to approve and promote in the Tines UI. Destroying this resource cancels
+the change request, unless it has already been promoted.`
+
+// Schema defines the schema for the resource.
+func (r *changeRequestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: CHANGE_REQUEST_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the change request, in the format story_id:change_request_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story with change control enabled.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"draft_id": schema.Int64Attribute{
+				Description: "The ID of the story draft to submit. If not set, the default draft of the story is submitted.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the change request.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A long-form description of the changes, shown to reviewers.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"wait_for_approval": schema.BoolAttribute{
+				Description: "Boolean flag indicating whether Terraform waits for the change request to be approved, and then promotes it. default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"approval_timeout": schema.StringAttribute{
+				Description: "How long to wait for approval when wait_for_approval is set, as a duration such as 30m or 2h. default: 30m.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRegex, "must be a duration such as 30m or 2h"),
+				},
+			},
+			"change_request_id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this change request.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the change request (OPEN, APPROVED, REJECTED, PROMOTED, CANCELLED).",
+				Computed:    true,
+			},
+			"approvers": schema.ListAttribute{
+				Description: "The email addresses of the users who have approved the change request.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan plans another wait when a change request that Terraform should promote is still pending, so
+// that a later apply picks it up after an approval timeout.
+func (r *changeRequestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to wait for when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan changeRequestResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.WaitForApproval.ValueBool() {
+		return
+	}
+
+	switch state.Status.ValueString() {
+	case "OPEN", "APPROVED":
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("approvers"), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// Create opens a new Tines Change Request and sets the initial Terraform state.
+func (r *changeRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Change Request")
+
+	var plan changeRequestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newChangeRequest := tines.ChangeRequest{
+		Title:       plan.Title.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	if !plan.DraftID.IsNull() && !plan.DraftID.IsUnknown() {
+		newChangeRequest.DraftID = int(plan.DraftID.ValueInt64())
+	}
+
+	changeRequest, err := r.client.CreateChangeRequest(ctx, int(plan.StoryID.ValueInt64()), &newChangeRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Change Request",
+			"Could not create change request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertChangeRequestToPlan(ctx, &plan, changeRequest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the state before waiting, so that the change request is tracked even if waiting fails.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.WaitForApproval.ValueBool() {
+		resp.Diagnostics.Append(r.waitAndPromote(ctx, &plan)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *changeRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState changeRequestResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetChangeRequest(ctx, int(localState.StoryID.ValueInt64()), int(localState.ChangeRequestID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	// The waiting behaviour only exists in Terraform, so fall back to the defaults when the
+	// resource is imported.
+	if localState.WaitForApproval.IsNull() {
+		localState.WaitForApproval = types.BoolValue(false)
+	}
+	if localState.ApprovalTimeout.IsNull() {
+		localState.ApprovalTimeout = types.StringValue("30m")
+	}
+
+	diags := r.convertChangeRequestToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Change Request and sets the updated Terraform state on success.
+func (r *changeRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Change Request")
+
+	var plan changeRequestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changeRequestUpdate := tines.ChangeRequest{
+		Title:       plan.Title.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	changeRequest, err := r.client.UpdateChangeRequest(ctx, int(plan.StoryID.ValueInt64()), int(plan.ChangeRequestID.ValueInt64()), &changeRequestUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Change Request",
+			"Could not update change request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertChangeRequestToPlan(ctx, &plan, changeRequest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes that are already promoted or closed can't be waited on again.
+	if plan.WaitForApproval.ValueBool() && (changeRequest.Status == "OPEN" || changeRequest.Status == "APPROVED") {
+		resp.Diagnostics.Append(r.waitAndPromote(ctx, &plan)...)
+	}
+
+	// Set state to fully populated data.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete cancels the Tines Change Request and removes the Terraform state on success.
+func (r *changeRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Change Request")
+
+	// Retrieve values from state
+	var state changeRequestResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Promoted and closed change requests are part of the story history and can't be cancelled.
+	switch state.Status.ValueString() {
+	case "PROMOTED", "REJECTED", "CANCELLED":
+		tflog.Info(ctx, "Tines Change Request is already closed, removing it from state only")
+		return
+	}
+
+	err := r.client.CancelChangeRequest(ctx, int(state.StoryID.ValueInt64()), int(state.ChangeRequestID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Change Request",
+			"Could not cancel change request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *changeRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Change Request")
+	// Retrieve the story and change request IDs from an import ID in the format story_id:change_request_id.
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Expected an import ID in the format story_id:change_request_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	storyID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Story, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	changeRequestID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Change Request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("story_id"), storyID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("change_request_id"), changeRequestID)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *changeRequestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// waitAndPromote polls the Tines Change Request until it is approved, then promotes the changes
+// to the live story. An error is returned if the change request is closed without approval. If it
+// isn't approved within the approval timeout, only a warning is returned, so that the pending
+// change request stays in state and a later apply can wait for it again.
+func (r *changeRequestResource) waitAndPromote(ctx context.Context, plan *changeRequestResourceModel) (diags diag.Diagnostics) {
+	storyID := int(plan.StoryID.ValueInt64())
+	changeRequestID := int(plan.ChangeRequestID.ValueInt64())
+
+	timeout, err := time.ParseDuration(plan.ApprovalTimeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("approval_timeout"),
+			"Invalid Approval Timeout",
+			"Could not parse the approval timeout, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// The timeout only bounds the wait between polls. API calls use the original context, so
+	// that a deadline passing mid-request can't fail the read or cancel a promotion part way.
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(changeRequestPollInterval)
+	defer ticker.Stop()
+
+	for plan.Status.ValueString() == "OPEN" {
+		tflog.Info(ctx, "Waiting for Tines Change Request approval")
+
+		select {
+		case <-waitCtx.Done():
+			diags.AddWarning(
+				"Timed Out Waiting for Tines Change Request Approval",
+				fmt.Sprintf("Change request %d was not approved within %s, so its changes were not promoted yet. "+
+					"Once it is approved in the Tines UI, run terraform apply again to promote it, "+
+					"or increase approval_timeout to wait longer.", changeRequestID, timeout),
+			)
+			return diags
+		case <-ticker.C:
+		}
+
+		changeRequest, err := r.client.GetChangeRequest(ctx, storyID, changeRequestID)
+		if err != nil {
+			diags.AddError(
+				"Error Reading Tines Change Request",
+				"Could not read change request, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+
+		diags.Append(r.convertChangeRequestToPlan(ctx, plan, changeRequest)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if plan.Status.ValueString() != "APPROVED" {
+		diags.AddError(
+			"Tines Change Request Not Approved",
+			fmt.Sprintf("Change request %d was closed with status %s, so its changes were not promoted.", changeRequestID, plan.Status.ValueString()),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, "Promoting Tines Change Request")
+	changeRequest, err := r.client.PromoteChangeRequest(ctx, storyID, changeRequestID)
+	if err != nil {
+		diags.AddError(
+			"Error Promoting Tines Change Request",
+			"Could not promote change request, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	diags.Append(r.convertChangeRequestToPlan(ctx, plan, changeRequest)...)
+
+	return diags
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *changeRequestResource) convertChangeRequestToPlan(ctx context.Context, plan *changeRequestResourceModel, changeRequest *tines.ChangeRequest) (diags diag.Diagnostics) {
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", changeRequest.StoryID, changeRequest.ID))
+	plan.StoryID = types.Int64Value(int64(changeRequest.StoryID))
+	if changeRequest.DraftID != 0 && !plan.DraftID.IsNull() {
+		plan.DraftID = types.Int64Value(int64(changeRequest.DraftID))
+	}
+	plan.Title = types.StringValue(changeRequest.Title)
+	plan.Description = types.StringValue(changeRequest.Description)
+	plan.ChangeRequestID = types.Int64Value(int64(changeRequest.ID))
+	plan.Status = types.StringValue(changeRequest.Status)
+	plan.Approvers, diags = types.ListValueFrom(ctx, types.StringType, changeRequest.Approvers)
+
+	return diags
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesChangeRequest_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Open a change request without waiting for approval.
+				Config: providerConfig + testAccCreateTinesChangeRequest("Terraform Test Change Request"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_change_request.test_change_request",
+							tfjsonpath.New("change_request_id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_change_request.test_change_request",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("OPEN"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_change_request.test_change_request",
+						tfjsonpath.New("wait_for_approval"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				// Changing the title updates the change request in place.
+				Config: providerConfig + testAccCreateTinesChangeRequest("Terraform Test Change Request Renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_change_request.test_change_request", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Import the existing change request.
+				ResourceName:      "tines_change_request.test_change_request",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTinesChangeRequest_approvalTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Nobody approves the change request, so waiting times out with a warning and the
+				// open change request is kept in state instead of being tainted.
+				Config: providerConfig + testAccCreateTinesChangeRequestWithApprovalTimeout("1s"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						// The next apply waits for approval again rather than replacing the change request.
+						plancheck.ExpectResourceAction("tines_change_request.test_change_request", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_change_request.test_change_request",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("OPEN"),
+					),
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCreateTinesChangeRequest(title string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_change_request_story" {
+	team_id = 30906
+	name = "Terraform Test Change Request Story"
+	change_control_enabled = true
+}
+
+resource "tines_change_request" "test_change_request" {
+	story_id = tines_story.test_change_request_story.id
+	title = %q
+	description = "Created by the Terraform acceptance tests."
+}
+`, title)
+}
+
+func testAccCreateTinesChangeRequestWithApprovalTimeout(timeout string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_change_request_story" {
+	team_id = 30906
+	name = "Terraform Test Change Request Timeout Story"
+	change_control_enabled = true
+}
+
+resource "tines_change_request" "test_change_request" {
+	story_id = tines_story.test_change_request_story.id
+	title = "Terraform Test Change Request Timeout"
+	wait_for_approval = true
+	approval_timeout = %q
+}
+`, timeout)
+}
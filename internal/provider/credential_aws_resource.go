@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialAWSResource is the resource implementation.
+type credentialAWSResource struct {
+	credentialResource
+}
+
+type credentialAWSResourceModel struct {
+	credentialResourceModel
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+	KeysVersion        types.Int64  `tfsdk:"keys_version"`
+	RoleARN            types.String `tfsdk:"role_arn"`
+	ExternalID         types.String `tfsdk:"external_id"`
+	SessionDuration    types.Int64  `tfsdk:"session_duration"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &credentialAWSResource{}
+	_ resource.ResourceWithConfigure      = &credentialAWSResource{}
+	_ resource.ResourceWithImportState    = &credentialAWSResource{}
+	_ resource.ResourceWithValidateConfig = &credentialAWSResource{}
+)
+
+// NewCredentialAWSResource is a helper function to simplify the provider implementation.
+func NewCredentialAWSResource() resource.Resource {
+	return &credentialAWSResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *credentialAWSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_aws"
+}
+
+const CREDENTIAL_AWS_RESOURCE_DESCRIPTION = `
+A Tines AWS Credential authenticates requests to AWS, either with a static access key and secret key (KEY), or by
+assuming an IAM role (ROLE). The access key and secret key are write-only attributes: they are sent to Tines but never
+stored in the Terraform plan or state. To rotate them, update them together with keys_version. Write-only attributes
+require Terraform 1.11 or later.`
+
+// Schema defines the schema for the resource.
+func (r *credentialAWSResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := credentialResourceAttributes("The supported keys are access_key and secret_key.")
+	attributes["authentication_type"] = schema.StringAttribute{
+		Description: "How the Tines Credential authenticates with AWS (KEY, ROLE).",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("KEY", "ROLE"),
+		},
+	}
+	attributes["access_key"] = schema.StringAttribute{
+		Description: "The AWS access key ID. Required when authentication_type is KEY. This value is never stored in Terraform state.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["secret_key"] = schema.StringAttribute{
+		Description: "The AWS secret access key. Required when authentication_type is KEY. This value is never stored in Terraform state.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["keys_version"] = schema.Int64Attribute{
+		Description: "An arbitrary version number for access_key and secret_key. The keys are only sent to Tines on create, or when this version changes.",
+		Optional:    true,
+	}
+	attributes["role_arn"] = schema.StringAttribute{
+		Description: "The ARN of the IAM role to assume. Required when authentication_type is ROLE.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["external_id"] = schema.StringAttribute{
+		Description: "The external ID passed when assuming the IAM role.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["session_duration"] = schema.Int64Attribute{
+		Description: "The duration, in seconds, of the assumed role session. Must be between 900 and 43200. default: 3600.",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(3600),
+		Validators: []validator.Int64{
+			int64validator.Between(900, 43200),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_AWS_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig checks that the attributes required by the configured authentication type are set.
+func (r *credentialAWSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Reject unsupported test_value keys before any change is made in Tines.
+	resp.Diagnostics.Append(validateTestValue(ctx, req.Config, awsTestValueFields(&tines.Credential{}))...)
+
+	var config credentialAWSResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch config.AuthenticationType.ValueString() {
+	case "KEY":
+		if config.AccessKey.IsNull() || config.SecretKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_key"),
+				"Missing AWS Keys",
+				"The access_key and secret_key attributes must be set when authentication_type is KEY.",
+			)
+		}
+		if !config.RoleARN.IsNull() || !config.ExternalID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_arn"),
+				"Invalid AWS Credential Configuration",
+				"The role_arn and external_id attributes can only be set when authentication_type is ROLE.",
+			)
+		}
+	case "ROLE":
+		if config.RoleARN.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_arn"),
+				"Missing AWS Role ARN",
+				"The role_arn attribute must be set when authentication_type is ROLE.",
+			)
+		}
+		if !config.AccessKey.IsNull() || !config.SecretKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_key"),
+				"Invalid AWS Credential Configuration",
+				"The access_key and secret_key attributes can only be set when authentication_type is KEY.",
+			)
+		}
+	}
+}
+
+// Create creates a new Tines Credential and sets the initial Terraform state.
+func (r *credentialAWSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines AWS Credential")
+
+	var plan credentialAWSResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCredential := tines.Credential{
+		Mode: "AWS",
+	}
+
+	diags = r.convertPlanToAWSCredential(ctx, &plan, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.setAWSKeys(ctx, req.Config, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateCredential(ctx, &newCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertAWSCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the test version of the Tines Credential, if one is configured.
+	var testCredential tines.Credential
+	diags = r.convertPlanToAWSCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "AWS"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, true, setAWSTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *credentialAWSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState credentialAWSResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, diags := r.readCredential(ctx, localState.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remoteState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = r.convertAWSCredentialToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Credential and sets the updated Terraform state on success.
+func (r *credentialAWSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines AWS Credential")
+
+	var plan, state credentialAWSResourceModel
+	var credentialUpdate tines.Credential
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToAWSCredential(ctx, &plan, &credentialUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The keys are only replaced when keys_version changes, since Terraform cannot detect
+	// changes to a write-only value on its own. Switching to KEY authentication always
+	// sends them, as Tines has no keys stored for a ROLE credential.
+	if !plan.KeysVersion.Equal(state.KeysVersion) || !plan.AuthenticationType.Equal(state.AuthenticationType) {
+		diags = r.setAWSKeys(ctx, req.Config, &credentialUpdate)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The test value is sent when test_value_version changes, or when the test credential
+	// doesn't exist yet.
+	sendTestValue := !plan.TestVersion.Equal(state.TestVersion) || state.TestCredID.IsNull()
+
+	credential, err := r.client.UpdateCredential(ctx, int(plan.ID.ValueInt64()), &credentialUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Credential",
+			"Could not update credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertAWSCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the test version of the Tines Credential in sync with the live credential.
+	var testCredential tines.Credential
+	diags = r.convertPlanToAWSCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "AWS"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, sendTestValue, setAWSTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Write-only values are only available in the configuration, never in the plan.
+func (r *credentialAWSResource) setAWSKeys(ctx context.Context, config tfsdk.Config, credential *tines.Credential) (diags diag.Diagnostics) {
+	var accessKey, secretKey types.String
+
+	diags.Append(config.GetAttribute(ctx, path.Root("access_key"), &accessKey)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_key"), &secretKey)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	credential.AWSAccessKey = accessKey.ValueString()
+	credential.AWSSecretKey = secretKey.ValueString()
+
+	return diags
+}
+
+func (r *credentialAWSResource) convertPlanToAWSCredential(ctx context.Context, plan *credentialAWSResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	credential.AWSAuthenticationType = plan.AuthenticationType.ValueString()
+	credential.AWSAssumedRoleARN = plan.RoleARN.ValueString()
+	credential.AWSAssumedRoleExternalID = plan.ExternalID.ValueString()
+	credential.AWSDuration = int(plan.SessionDuration.ValueInt64())
+
+	return diags
+}
+
+func (r *credentialAWSResource) convertAWSCredentialToPlan(ctx context.Context, plan *credentialAWSResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.AuthenticationType = types.StringValue(credential.AWSAuthenticationType)
+	if credential.AWSAssumedRoleARN != "" {
+		plan.RoleARN = types.StringValue(credential.AWSAssumedRoleARN)
+	} else {
+		plan.RoleARN = types.StringNull()
+	}
+	if credential.AWSAssumedRoleExternalID != "" {
+		plan.ExternalID = types.StringValue(credential.AWSAssumedRoleExternalID)
+	} else {
+		plan.ExternalID = types.StringNull()
+	}
+	plan.SessionDuration = types.Int64Value(int64(credential.AWSDuration))
+
+	return diags
+}
+
+// Maps the keys supported in test_value to the secret fields of an AWS Credential.
+func awsTestValueFields(credential *tines.Credential) map[string]*string {
+	return map[string]*string{
+		"access_key": &credential.AWSAccessKey,
+		"secret_key": &credential.AWSSecretKey,
+	}
+}
+
+// Sets the secret fields of the test version of an AWS Credential from test_value.
+func setAWSTestValue(credential *tines.Credential, values map[string]string) diag.Diagnostics {
+	return setTestValueFields(values, awsTestValueFields(credential))
+}
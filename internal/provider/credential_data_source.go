@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialDataSource is the data source implementation.
+type credentialDataSource struct {
+	client *tines.Client
+}
+
+// credentialDataModel describes the metadata of a single Tines Credential. It is
+// shared by the tines_credential and tines_credentials data sources. The credential
+// value is intentionally absent: these data sources must never expose secrets.
+type credentialDataModel struct {
+	ID                    types.Int64  `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Slug                  types.String `tfsdk:"slug"`
+	Type                  types.String `tfsdk:"type"`
+	Description           types.String `tfsdk:"description"`
+	TeamID                types.Int64  `tfsdk:"team_id"`
+	FolderID              types.Int64  `tfsdk:"folder_id"`
+	ReadAccess            types.String `tfsdk:"read_access"`
+	SharedTeamSlugs       types.List   `tfsdk:"shared_team_slugs"`
+	LiveCredentialID      types.Int64  `tfsdk:"live_credential_id"`
+	TestCredentialEnabled types.Bool   `tfsdk:"test_credential_enabled"`
+	IsTest                types.Bool   `tfsdk:"is_test"`
+	RefActions            types.List   `tfsdk:"referencing_action_ids"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &credentialDataSource{}
+	_ datasource.DataSourceWithConfigure = &credentialDataSource{}
+)
+
+// NewCredentialDataSource is a helper function to simplify the provider implementation.
+func NewCredentialDataSource() datasource.DataSource {
+	return &credentialDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *credentialDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+const CREDENTIAL_DATA_SOURCE_DESCRIPTION = `
+Use this data source to look up the metadata of a single Tines Credential, either by its ID or by its name within a team.
+This is useful to confirm that a credential referenced by a story export exists before the story is imported. The secret
+value of the credential is never returned.`
+
+// Schema defines the schema for the data source.
+func (d *credentialDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this Tines Credential. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the Tines Credential. Looking up a credential by name also requires team_id to be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("team_id")),
+				},
+			},
+			"team_id": schema.Int64Attribute{
+				Description: "The ID of the Tines Team where this Tines Credential is located.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"slug":                    credentialDataAttributes["slug"],
+			"type":                    credentialDataAttributes["type"],
+			"description":             credentialDataAttributes["description"],
+			"folder_id":               credentialDataAttributes["folder_id"],
+			"read_access":             credentialDataAttributes["read_access"],
+			"shared_team_slugs":       credentialDataAttributes["shared_team_slugs"],
+			"live_credential_id":      credentialDataAttributes["live_credential_id"],
+			"test_credential_enabled": credentialDataAttributes["test_credential_enabled"],
+			"is_test":                 credentialDataAttributes["is_test"],
+			"referencing_action_ids":  credentialDataAttributes["referencing_action_ids"],
+			"created_at":              credentialDataAttributes["created_at"],
+			"updated_at":              credentialDataAttributes["updated_at"],
+		},
+	}
+}
+
+// credentialDataAttributes holds the read-only schema for every credentialDataModel attribute.
+var credentialDataAttributes = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		Description: "The Tines-generated identifier for this Tines Credential.",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "The name of the Tines Credential.",
+		Computed:    true,
+	},
+	"slug": schema.StringAttribute{
+		Description: "An underscored representation of the Tines Credential name, as used in formulas.",
+		Computed:    true,
+	},
+	"type": schema.StringAttribute{
+		Description: "The type of the Tines Credential (e.g. TEXT, OAUTH, AWS, JWT, HTTP_REQUEST_AGENT, MTLS).",
+		Computed:    true,
+	},
+	"description": schema.StringAttribute{
+		Description: "A long-form description of the Tines Credential.",
+		Computed:    true,
+	},
+	"team_id": schema.Int64Attribute{
+		Description: "The ID of the Tines Team where this Tines Credential is located.",
+		Computed:    true,
+	},
+	"folder_id": schema.Int64Attribute{
+		Description: "The ID of the folder where this Tines Credential is located.",
+		Computed:    true,
+	},
+	"read_access": schema.StringAttribute{
+		Description: "Controls who is allowed to use this Tines Credential (TEAM, GLOBAL, SPECIFIC_TEAMS).",
+		Computed:    true,
+	},
+	"shared_team_slugs": schema.ListAttribute{
+		Description: "List of teams' slugs where this Tines Credential can be used.",
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+	"live_credential_id": schema.Int64Attribute{
+		Description: "The ID of the live Tines Credential, if this is the test version of a credential.",
+		Computed:    true,
+	},
+	"test_credential_enabled": schema.BoolAttribute{
+		Description: "Boolean flag indicating whether a test value is used for this Tines Credential during non-production Story execution.",
+		Computed:    true,
+	},
+	"is_test": schema.BoolAttribute{
+		Description: "Boolean flag indicating whether this is the test version of a Tines Credential.",
+		Computed:    true,
+	},
+	"referencing_action_ids": schema.ListAttribute{
+		Description: "A list of Action IDs in Tines Stories that reference this Tines Credential.",
+		ElementType: types.Int64Type,
+		Computed:    true,
+	},
+	"created_at": schema.StringAttribute{
+		Description: "The ISO 8601 Timestamp representing date and time the Tines Credential was created.",
+		Computed:    true,
+	},
+	"updated_at": schema.StringAttribute{
+		Description: "The ISO 8601 Timestamp representing date and time the Tines Credential was last updated.",
+		Computed:    true,
+	},
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *credentialDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Credential")
+
+	var state credentialDataModel
+	var credential *tines.Credential
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ID.IsNull() {
+		var err error
+		credential, err = d.client.GetCredential(ctx, int(state.ID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tines Credential",
+				"An unexpected error occurred while attempting to read the credential. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		credentials, err := d.client.ListCredentials(ctx, int(state.TeamID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tines Credentials",
+				"An unexpected error occurred while attempting to list credentials. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+			return
+		}
+
+		for i := range credentials {
+			// Test versions of a credential share the name of their live credential.
+			if credentials[i].Name == state.Name.ValueString() && !credentials[i].IsTest {
+				credential = &credentials[i]
+				break
+			}
+		}
+
+		if credential == nil {
+			resp.Diagnostics.AddError(
+				"Tines Credential Not Found",
+				fmt.Sprintf("No credential named %q could be found in team %d.", state.Name.ValueString(), state.TeamID.ValueInt64()),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(convertCredentialToDataModel(ctx, &state, credential)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *credentialDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// This is reused by both the tines_credential and tines_credentials data sources.
+func convertCredentialToDataModel(ctx context.Context, model *credentialDataModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	model.ID = types.Int64Value(int64(credential.ID))
+	model.Name = types.StringValue(credential.Name)
+	model.Slug = types.StringValue(credential.Slug)
+	model.Type = types.StringValue(credential.Mode)
+	model.Description = types.StringValue(credential.Description)
+	model.TeamID = types.Int64Value(int64(credential.TeamID))
+	model.FolderID = types.Int64Value(int64(credential.FolderID))
+	model.ReadAccess = types.StringValue(credential.ReadAccess)
+	model.SharedTeamSlugs, diags = types.ListValueFrom(ctx, types.StringType, credential.SharedTeamSlugs)
+	if diags.HasError() {
+		return diags
+	}
+	model.LiveCredentialID = types.Int64Value(int64(credential.LiveCredentialID))
+	model.TestCredentialEnabled = types.BoolValue(credential.TestCredentialEnabled)
+	model.IsTest = types.BoolValue(credential.IsTest)
+	model.RefActions, diags = types.ListValueFrom(ctx, types.Int64Type, credential.ReferencingActionIDs)
+	if diags.HasError() {
+		return diags
+	}
+	model.CreatedAt = types.StringValue(credential.CreatedAt)
+	model.UpdatedAt = types.StringValue(credential.UpdatedAt)
+
+	return diags
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTinesCredentialDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccTinesCredentialDataSourceBadConfig(),
+				ExpectError: regexp.MustCompile("Attribute \"team_id\" must be specified when \"name\" is specified"),
+			},
+			{
+				Config:      providerConfig + testAccTinesCredentialDataSourceNotFound(),
+				ExpectError: regexp.MustCompile("Tines Credential Not Found"),
+			},
+		},
+	})
+}
+
+func testAccTinesCredentialDataSourceBadConfig() string {
+	return `
+data "tines_credential" "test_bad_config" {
+	name = "terraform_missing_credential"
+}
+	`
+}
+
+func testAccTinesCredentialDataSourceNotFound() string {
+	return `
+data "tines_credential" "test_not_found" {
+	team_id = 30906
+	name = "terraform_missing_credential"
+}
+	`
+}
@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+	"github.com/tines/terraform-provider-tines/internal/utils"
+)
+
+// credentialHTTPRequestResource is the resource implementation.
+type credentialHTTPRequestResource struct {
+	credentialResource
+}
+
+type credentialHTTPRequestResourceModel struct {
+	credentialResourceModel
+	Steps         types.Dynamic `tfsdk:"steps"`
+	TokenFormula  types.String  `tfsdk:"token_formula"`
+	Inputs        types.Map     `tfsdk:"inputs"`
+	InputsVersion types.Int64   `tfsdk:"inputs_version"`
+}
+
+// The HTTP methods that can be used in a step.
+var httpRequestStepMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &credentialHTTPRequestResource{}
+	_ resource.ResourceWithConfigure      = &credentialHTTPRequestResource{}
+	_ resource.ResourceWithImportState    = &credentialHTTPRequestResource{}
+	_ resource.ResourceWithValidateConfig = &credentialHTTPRequestResource{}
+)
+
+// NewCredentialHTTPRequestResource is a helper function to simplify the provider implementation.
+func NewCredentialHTTPRequestResource() resource.Resource {
+	return &credentialHTTPRequestResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *credentialHTTPRequestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_http_request"
+}
+
+const CREDENTIAL_HTTP_REQUEST_RESOURCE_DESCRIPTION = `
+A Tines HTTP Request Credential fetches a token by running one or more HTTP requests in order, then extracts the token
+from the final response with a formula. Secrets such as passwords should be passed through the write-only inputs
+attribute and referenced from the steps as <<INPUTS.name>>, rather than written into the steps directly. Inputs are sent
+to Tines but never stored in the Terraform plan or state. To rotate them, update them together with inputs_version.
+Write-only attributes require Terraform 1.11 or later.`
+
+// Schema defines the schema for the resource.
+func (r *credentialHTTPRequestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := credentialResourceAttributes("The keys are the names of the inputs referenced from the steps.")
+	// The headers and body of each step can hold any value, and the framework doesn't support
+	// dynamic attributes inside a list of nested attributes, so the whole list is dynamic and
+	// its structure is checked in ValidateConfig instead.
+	attributes["steps"] = schema.DynamicAttribute{
+		Description: "The HTTP requests to run, in order. Each step is an object with a url, a method (" + strings.Join(httpRequestStepMethods, ", ") + "), " +
+			"and optional headers and body, which can be any value such as a nested object. Each step can reference the response of the previous step as <<previous_request.body>>.",
+		Required: true,
+	}
+	attributes["token_formula"] = schema.StringAttribute{
+		Description: "The formula that extracts the token from the response of the final step, such as <<response.body.access_token>>.",
+		Required:    true,
+	}
+	attributes["inputs"] = schema.MapAttribute{
+		Description: "Secret values that can be referenced from the steps as <<INPUTS.name>>. This value is never stored in Terraform state.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["inputs_version"] = schema.Int64Attribute{
+		Description: "An arbitrary version number for inputs. The inputs are only sent to Tines on create, or when this version changes.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_HTTP_REQUEST_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig checks the structure of the steps, since they can't be described by the schema.
+func (r *credentialHTTPRequestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var steps types.Dynamic
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("steps"), &steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The steps may reference other resources that haven't been created yet.
+	value, err := steps.ToTerraformValue(ctx)
+	if err != nil || steps.IsNull() || !value.IsFullyKnown() {
+		return
+	}
+
+	_, diags := httpRequestStepsFromDynamic(ctx, steps)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates a new Tines Credential and sets the initial Terraform state.
+func (r *credentialHTTPRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines HTTP Request Credential")
+
+	var plan credentialHTTPRequestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCredential := tines.Credential{
+		Mode: "HTTP_REQUEST_AGENT",
+	}
+
+	diags = r.convertPlanToHTTPRequestCredential(ctx, &plan, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.setHTTPRequestInputs(ctx, req.Config, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateCredential(ctx, &newCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertHTTPRequestCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the test version of the Tines Credential, if one is configured.
+	var testCredential tines.Credential
+	diags = r.convertPlanToHTTPRequestCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "HTTP_REQUEST_AGENT"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, true, setHTTPRequestTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *credentialHTTPRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState credentialHTTPRequestResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, diags := r.readCredential(ctx, localState.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remoteState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = r.convertHTTPRequestCredentialToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Credential and sets the updated Terraform state on success.
+func (r *credentialHTTPRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines HTTP Request Credential")
+
+	var plan, state credentialHTTPRequestResourceModel
+	var credentialUpdate tines.Credential
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToHTTPRequestCredential(ctx, &plan, &credentialUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The inputs are only replaced when inputs_version changes, since Terraform cannot
+	// detect changes to a write-only value on its own.
+	if !plan.InputsVersion.Equal(state.InputsVersion) {
+		diags = r.setHTTPRequestInputs(ctx, req.Config, &credentialUpdate)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The test value is sent when test_value_version changes, or when the test credential
+	// doesn't exist yet.
+	sendTestValue := !plan.TestVersion.Equal(state.TestVersion) || state.TestCredID.IsNull()
+
+	credential, err := r.client.UpdateCredential(ctx, int(plan.ID.ValueInt64()), &credentialUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Credential",
+			"Could not update credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertHTTPRequestCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the test version of the Tines Credential in sync with the live credential.
+	var testCredential tines.Credential
+	diags = r.convertPlanToHTTPRequestCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "HTTP_REQUEST_AGENT"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, sendTestValue, setHTTPRequestTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Write-only values are only available in the configuration, never in the plan.
+func (r *credentialHTTPRequestResource) setHTTPRequestInputs(ctx context.Context, config tfsdk.Config, credential *tines.Credential) (diags diag.Diagnostics) {
+	var inputs types.Map
+
+	diags = config.GetAttribute(ctx, path.Root("inputs"), &inputs)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !inputs.IsNull() && !inputs.IsUnknown() {
+		diags = inputs.ElementsAs(ctx, &credential.HTTPRequestInputs, false)
+	}
+
+	return diags
+}
+
+func (r *credentialHTTPRequestResource) convertPlanToHTTPRequestCredential(ctx context.Context, plan *credentialHTTPRequestResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	credential.HTTPRequestTokenFormula = plan.TokenFormula.ValueString()
+	credential.HTTPRequestSteps, diags = httpRequestStepsFromDynamic(ctx, plan.Steps)
+
+	return diags
+}
+
+func (r *credentialHTTPRequestResource) convertHTTPRequestCredentialToPlan(ctx context.Context, plan *credentialHTTPRequestResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.TokenFormula = types.StringValue(credential.HTTPRequestTokenFormula)
+
+	// Unset headers and bodies are left out, to match a configuration that omits them.
+	steps := make([]map[string]any, 0, len(credential.HTTPRequestSteps))
+	for _, s := range credential.HTTPRequestSteps {
+		step := map[string]any{
+			"url":    s.URL,
+			"method": s.Method,
+		}
+		if s.Headers != nil {
+			step["headers"] = s.Headers
+		}
+		if s.Body != nil {
+			step["body"] = s.Body
+		}
+		steps = append(steps, step)
+	}
+	plan.Steps, diags = utils.DynamicValueFromAny(ctx, steps)
+
+	return diags
+}
+
+// Converts the steps attribute to the steps of an API request body. The steps must be a list of
+// objects with a url and a method, and optional headers and body.
+func httpRequestStepsFromDynamic(ctx context.Context, value types.Dynamic) (steps []tines.HTTPRequestStep, diags diag.Diagnostics) {
+	stepsPath := path.Root("steps")
+
+	raw, diags := utils.GetUnderlyingDynamicValue(ctx, &value)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	items, ok := raw.([]any)
+	if !ok || len(items) == 0 {
+		diags.AddAttributeError(
+			stepsPath,
+			"Invalid HTTP Request Steps",
+			"The steps attribute must be a list containing at least one step.",
+		)
+		return nil, diags
+	}
+
+	steps = make([]tines.HTTPRequestStep, 0, len(items))
+	for i, item := range items {
+		stepPath := stepsPath.AtListIndex(i)
+
+		fields, ok := item.(map[string]any)
+		if !ok {
+			diags.AddAttributeError(stepPath, "Invalid HTTP Request Step", "Each step must be an object.")
+			continue
+		}
+
+		for key := range fields {
+			switch key {
+			case "url", "method", "headers", "body":
+			default:
+				diags.AddAttributeError(
+					stepPath,
+					"Invalid HTTP Request Step",
+					fmt.Sprintf("Unsupported attribute %q. Each step can only contain url, method, headers and body.", key),
+				)
+			}
+		}
+
+		url, ok := fields["url"].(string)
+		if !ok || url == "" {
+			diags.AddAttributeError(stepPath, "Invalid HTTP Request Step", "Each step must have a url string.")
+		}
+
+		method, _ := fields["method"].(string)
+		if !slices.Contains(httpRequestStepMethods, method) {
+			diags.AddAttributeError(
+				stepPath,
+				"Invalid HTTP Request Step",
+				"Each step must have a method, one of: "+strings.Join(httpRequestStepMethods, ", ")+".",
+			)
+		}
+
+		if headers, ok := fields["headers"]; ok && headers != nil {
+			if _, isObject := headers.(map[string]any); !isObject {
+				diags.AddAttributeError(stepPath, "Invalid HTTP Request Step", "The headers of a step must be an object.")
+			}
+		}
+
+		steps = append(steps, tines.HTTPRequestStep{
+			URL:     url,
+			Method:  method,
+			Headers: fields["headers"],
+			Body:    fields["body"],
+		})
+	}
+
+	return steps, diags
+}
+
+// Sets the inputs of the test version of an HTTP Request Credential from test_value. Any
+// input name is accepted, since the steps may reference inputs that only exist in test.
+func setHTTPRequestTestValue(credential *tines.Credential, values map[string]string) diag.Diagnostics {
+	credential.HTTPRequestInputs = values
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTinesCredentialHTTPRequest_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the Tines Credential.
+				Config: providerConfig + testAccCreateTinesCredentialHTTPRequest("https://example.com/login", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_credential_http_request.test_http_request_credential",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_http_request.test_http_request_credential",
+						tfjsonpath.New("steps").AtSliceIndex(0).AtMapKey("method"),
+						knownvalue.StringExact("POST"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_http_request.test_http_request_credential",
+						tfjsonpath.New("steps").AtSliceIndex(1).AtMapKey("body"),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"grant": knownvalue.StringExact("session"),
+							"scopes": knownvalue.TupleExact([]knownvalue.Check{
+								knownvalue.StringExact("read"),
+								knownvalue.StringExact("write"),
+							}),
+							"ttl": knownvalue.Int64Exact(3600),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_http_request.test_http_request_credential",
+						tfjsonpath.New("steps").AtSliceIndex(2).AtMapKey("method"),
+						knownvalue.StringExact("GET"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_http_request.test_http_request_credential",
+						tfjsonpath.New("inputs"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Update the steps in place.
+				Config: providerConfig + testAccCreateTinesCredentialHTTPRequest("https://example.com/v2/login", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_http_request.test_http_request_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Rotate the inputs by bumping inputs_version.
+				Config: providerConfig + testAccCreateTinesCredentialHTTPRequest("https://example.com/v2/login", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_http_request.test_http_request_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Import the existing Tines Credential.
+				ResourceName:            "tines_credential_http_request.test_http_request_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inputs_version"},
+			},
+		},
+	})
+}
+
+func TestAccTinesCredentialHTTPRequest_invalidStep(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tines_credential_http_request" "test_http_request_credential" {
+	team_id = 30906
+	name = "Terraform Test HTTP Request Credential"
+	steps = [
+		{
+			url = "https://example.com/login"
+			method = "FETCH"
+		},
+	]
+	token_formula = "<<response.body.token>>"
+}
+	`,
+				ExpectError: regexp.MustCompile("Invalid HTTP Request Step"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCredentialHTTPRequest(loginURL string, version int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_http_request" "test_http_request_credential" {
+	team_id = 30906
+	name = "Terraform Test HTTP Request Credential"
+	steps = [
+		{
+			url = %q
+			method = "POST"
+			headers = {
+				"Content-Type" = "application/json"
+			}
+			body = jsonencode({
+				password = "<<INPUTS.password>>"
+			})
+		},
+		{
+			url = "https://example.com/session"
+			method = "POST"
+			body = {
+				grant = "session"
+				scopes = ["read", "write"]
+				ttl = 3600
+			}
+		},
+		{
+			url = "https://example.com/token"
+			method = "GET"
+		},
+	]
+	token_formula = "<<response.body.token>>"
+	inputs = {
+		password = "terraform-test-password"
+	}
+	inputs_version = %d
+}
+	`, loginURL, version)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+	"github.com/tines/terraform-provider-tines/internal/utils"
+)
+
+// credentialJWTResource is the resource implementation.
+type credentialJWTResource struct {
+	credentialResource
+}
+
+type credentialJWTResourceModel struct {
+	credentialResourceModel
+	Algorithm              types.String  `tfsdk:"algorithm"`
+	Payload                types.Dynamic `tfsdk:"payload"`
+	AutoGenerateTimeClaims types.Bool    `tfsdk:"auto_generate_time_claims"`
+	PrivateKey             types.String  `tfsdk:"private_key"`
+	PrivateKeyVersion      types.Int64   `tfsdk:"private_key_version"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &credentialJWTResource{}
+	_ resource.ResourceWithConfigure      = &credentialJWTResource{}
+	_ resource.ResourceWithImportState    = &credentialJWTResource{}
+	_ resource.ResourceWithValidateConfig = &credentialJWTResource{}
+)
+
+// NewCredentialJWTResource is a helper function to simplify the provider implementation.
+func NewCredentialJWTResource() resource.Resource {
+	return &credentialJWTResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *credentialJWTResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_jwt"
+}
+
+const CREDENTIAL_JWT_RESOURCE_DESCRIPTION = `
+A Tines JWT Credential signs a JSON Web Token with the configured claims each time it is used. The private key is a
+write-only attribute: it is sent to Tines but never stored in the Terraform plan or state. To rotate it, update it
+together with private_key_version. Write-only attributes require Terraform 1.11 or later.`
+
+// Schema defines the schema for the resource.
+func (r *credentialJWTResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := credentialResourceAttributes("The only supported key is private_key.")
+	attributes["algorithm"] = schema.StringAttribute{
+		Description: "The algorithm used to sign the JWT (HS256, RS256).",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("HS256", "RS256"),
+		},
+	}
+	attributes["payload"] = schema.DynamicAttribute{
+		Description: "The claims of the JWT as an object, such as iss, sub and aud.",
+		Required:    true,
+	}
+	attributes["auto_generate_time_claims"] = schema.BoolAttribute{
+		Description: "Boolean flag indicating whether Tines adds the iat and exp claims to the payload each time the JWT is signed. default: true.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	}
+	attributes["private_key"] = schema.StringAttribute{
+		Description: "The PEM-encoded private key for RS256, or the shared secret for HS256. This value is never stored in Terraform state.",
+		Required:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["private_key_version"] = schema.Int64Attribute{
+		Description: "An arbitrary version number for private_key. The key is only sent to Tines on create, or when this version changes.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_JWT_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig checks the keys of test_value, which can't be described by the schema.
+func (r *credentialJWTResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateTestValue(ctx, req.Config, jwtTestValueFields(&tines.Credential{}))...)
+}
+
+// Create creates a new Tines Credential and sets the initial Terraform state.
+func (r *credentialJWTResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines JWT Credential")
+
+	var plan credentialJWTResourceModel
+	var privateKey types.String
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	diags = req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCredential := tines.Credential{
+		Mode:          "JWT",
+		JWTPrivateKey: privateKey.ValueString(),
+	}
+
+	diags = r.convertPlanToJWTCredential(ctx, &plan, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateCredential(ctx, &newCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertJWTCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the test version of the Tines Credential, if one is configured.
+	var testCredential tines.Credential
+	diags = r.convertPlanToJWTCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "JWT"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, true, setJWTTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *credentialJWTResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState credentialJWTResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, diags := r.readCredential(ctx, localState.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remoteState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = r.convertJWTCredentialToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Credential and sets the updated Terraform state on success.
+func (r *credentialJWTResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines JWT Credential")
+
+	var plan, state credentialJWTResourceModel
+	var credentialUpdate tines.Credential
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToJWTCredential(ctx, &plan, &credentialUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The private key is only replaced when private_key_version changes, since Terraform
+	// cannot detect changes to a write-only value on its own.
+	if !plan.PrivateKeyVersion.Equal(state.PrivateKeyVersion) {
+		var privateKey types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		credentialUpdate.JWTPrivateKey = privateKey.ValueString()
+	}
+
+	// The test value is sent when test_value_version changes, or when the test credential
+	// doesn't exist yet.
+	sendTestValue := !plan.TestVersion.Equal(state.TestVersion) || state.TestCredID.IsNull()
+
+	credential, err := r.client.UpdateCredential(ctx, int(plan.ID.ValueInt64()), &credentialUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Credential",
+			"Could not update credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertJWTCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the test version of the Tines Credential in sync with the live credential.
+	var testCredential tines.Credential
+	diags = r.convertPlanToJWTCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "JWT"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, sendTestValue, setJWTTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *credentialJWTResource) convertPlanToJWTCredential(ctx context.Context, plan *credentialJWTResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	credential.JWTAlgorithm = plan.Algorithm.ValueString()
+	credential.JWTAutoGenerateTimeClaims = plan.AutoGenerateTimeClaims.ValueBool()
+	credential.JWTPayload, diags = utils.GetUnderlyingDynamicValue(ctx, &plan.Payload)
+
+	return diags
+}
+
+func (r *credentialJWTResource) convertJWTCredentialToPlan(ctx context.Context, plan *credentialJWTResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.Algorithm = types.StringValue(credential.JWTAlgorithm)
+	plan.AutoGenerateTimeClaims = types.BoolValue(credential.JWTAutoGenerateTimeClaims)
+
+	plan.Payload, diags = utils.DynamicValueFromAny(ctx, credential.JWTPayload)
+
+	return diags
+}
+
+// Maps the keys supported in test_value to the secret fields of a JWT Credential.
+func jwtTestValueFields(credential *tines.Credential) map[string]*string {
+	return map[string]*string{
+		"private_key": &credential.JWTPrivateKey,
+	}
+}
+
+// Sets the secret fields of the test version of a JWT Credential from test_value.
+func setJWTTestValue(credential *tines.Credential, values map[string]string) diag.Diagnostics {
+	return setTestValueFields(values, jwtTestValueFields(credential))
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTinesCredentialJWT_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the Tines Credential.
+				Config: providerConfig + testAccCreateTinesCredentialJWT("terraform-test", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_credential_jwt.test_jwt_credential",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_jwt.test_jwt_credential",
+						tfjsonpath.New("payload").AtMapKey("sub"),
+						knownvalue.StringExact("terraform-test"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_jwt.test_jwt_credential",
+						tfjsonpath.New("auto_generate_time_claims"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_jwt.test_jwt_credential",
+						tfjsonpath.New("private_key"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Update the payload claims in place.
+				Config: providerConfig + testAccCreateTinesCredentialJWT("terraform-test-updated", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_jwt.test_jwt_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Rotate the private key by bumping private_key_version.
+				Config: providerConfig + testAccCreateTinesCredentialJWT("terraform-test-updated", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_jwt.test_jwt_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Import the existing Tines Credential.
+				ResourceName:            "tines_credential_jwt.test_jwt_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key_version"},
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCredentialJWT(subject string, version int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_jwt" "test_jwt_credential" {
+	team_id = 30906
+	name = "Terraform Test JWT Credential"
+	algorithm = "HS256"
+	payload = {
+		iss = "terraform"
+		sub = %q
+	}
+	private_key = "terraform-test-shared-secret"
+	private_key_version = %d
+}
+	`, subject, version)
+}
@@ -0,0 +1,411 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialMTLSResource is the resource implementation.
+type credentialMTLSResource struct {
+	credentialResource
+}
+
+type credentialMTLSResourceModel struct {
+	credentialResourceModel
+	Certificate       types.String `tfsdk:"certificate"`
+	PrivateKey        types.String `tfsdk:"private_key"`
+	PrivateKeyVersion types.Int64  `tfsdk:"private_key_version"`
+	CACertificate     types.String `tfsdk:"ca_certificate"`
+	ExpiryWarningDays types.Int64  `tfsdk:"expiry_warning_days"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	Subject           types.String `tfsdk:"subject"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &credentialMTLSResource{}
+	_ resource.ResourceWithConfigure      = &credentialMTLSResource{}
+	_ resource.ResourceWithImportState    = &credentialMTLSResource{}
+	_ resource.ResourceWithValidateConfig = &credentialMTLSResource{}
+	_ resource.ResourceWithModifyPlan     = &credentialMTLSResource{}
+)
+
+// NewCredentialMTLSResource is a helper function to simplify the provider implementation.
+func NewCredentialMTLSResource() resource.Resource {
+	return &credentialMTLSResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *credentialMTLSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_mtls"
+}
+
+const CREDENTIAL_MTLS_RESOURCE_DESCRIPTION = `
+A Tines mTLS Credential presents a client certificate when Tines connects to an API that requires mutual TLS. The
+private key is a write-only attribute: it is sent to Tines but never stored in the Terraform plan or state. To rotate
+it, update it together with private_key_version. Write-only attributes require Terraform 1.11 or later. A warning is
+shown at plan time when the certificate expires within expiry_warning_days.`
+
+// Schema defines the schema for the resource.
+func (r *credentialMTLSResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := credentialResourceAttributes("The only supported key is private_key.")
+	attributes["certificate"] = schema.StringAttribute{
+		Description: "The PEM-encoded client certificate.",
+		Required:    true,
+	}
+	attributes["private_key"] = schema.StringAttribute{
+		Description: "The PEM-encoded private key of the client certificate. This value is never stored in Terraform state.",
+		Required:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["private_key_version"] = schema.Int64Attribute{
+		Description: "An arbitrary version number for private_key. The key is only sent to Tines on create, or when this version changes.",
+		Optional:    true,
+	}
+	attributes["ca_certificate"] = schema.StringAttribute{
+		Description: "The PEM-encoded chain of CA certificates used to verify the server.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["expiry_warning_days"] = schema.Int64Attribute{
+		Description: "Show a warning at plan time when the certificate expires within this many days. Set to 0 to disable the warning. default: 30.",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(30),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+	attributes["expires_at"] = schema.StringAttribute{
+		Description: "The ISO 8601 Timestamp representing date and time the certificate expires.",
+		Computed:    true,
+	}
+	attributes["subject"] = schema.StringAttribute{
+		Description: "The distinguished name of the certificate subject.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_MTLS_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// ModifyPlan populates the certificate metadata at plan time, and warns when the certificate is about to expire.
+func (r *credentialMTLSResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan credentialMTLSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The certificate may come from another resource that hasn't been created yet.
+	if plan.Certificate.IsUnknown() {
+		return
+	}
+
+	cert, err := parseCertificate(plan.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Invalid Certificate",
+			"Could not parse the client certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), cert.NotAfter.UTC().Format(time.RFC3339))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("subject"), cert.Subject.String())...)
+
+	warningDays := plan.ExpiryWarningDays.ValueInt64()
+	if plan.ExpiryWarningDays.IsUnknown() || warningDays == 0 {
+		return
+	}
+
+	remaining := time.Until(cert.NotAfter)
+	if remaining < time.Duration(warningDays)*24*time.Hour {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Certificate Expiring Soon",
+			fmt.Sprintf("The client certificate %q expires at %s, which is within %d day(s). "+
+				"Rotate the certificate and private key before it expires.", cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339), warningDays),
+		)
+	}
+}
+
+// ValidateConfig checks the keys of test_value, which can't be described by the schema.
+func (r *credentialMTLSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateTestValue(ctx, req.Config, mtlsTestValueFields(&tines.Credential{}))...)
+}
+
+// Create creates a new Tines Credential and sets the initial Terraform state.
+func (r *credentialMTLSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines mTLS Credential")
+
+	var plan credentialMTLSResourceModel
+	var privateKey types.String
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	diags = req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCredential := tines.Credential{
+		Mode:           "MTLS",
+		MTLSPrivateKey: privateKey.ValueString(),
+	}
+
+	diags = r.convertPlanToMTLSCredential(ctx, &plan, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateCredential(ctx, &newCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertMTLSCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the test version of the Tines Credential, if one is configured.
+	var testCredential tines.Credential
+	diags = r.convertPlanToMTLSCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "MTLS"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, true, setMTLSTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *credentialMTLSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState credentialMTLSResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, diags := r.readCredential(ctx, localState.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remoteState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The warning window is a provider-side setting, so it falls back to the default
+	// when the resource is imported.
+	if localState.ExpiryWarningDays.IsNull() {
+		localState.ExpiryWarningDays = types.Int64Value(30)
+	}
+
+	diags = r.convertMTLSCredentialToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Credential and sets the updated Terraform state on success.
+func (r *credentialMTLSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines mTLS Credential")
+
+	var plan, state credentialMTLSResourceModel
+	var credentialUpdate tines.Credential
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToMTLSCredential(ctx, &plan, &credentialUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The private key is only replaced when private_key_version changes, since Terraform
+	// cannot detect changes to a write-only value on its own.
+	if !plan.PrivateKeyVersion.Equal(state.PrivateKeyVersion) {
+		var privateKey types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		credentialUpdate.MTLSPrivateKey = privateKey.ValueString()
+	}
+
+	// The test value is sent when test_value_version changes, or when the test credential
+	// doesn't exist yet.
+	sendTestValue := !plan.TestVersion.Equal(state.TestVersion) || state.TestCredID.IsNull()
+
+	credential, err := r.client.UpdateCredential(ctx, int(plan.ID.ValueInt64()), &credentialUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Credential",
+			"Could not update credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertMTLSCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the test version of the Tines Credential in sync with the live credential.
+	var testCredential tines.Credential
+	diags = r.convertPlanToMTLSCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "MTLS"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, sendTestValue, setMTLSTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *credentialMTLSResource) convertPlanToMTLSCredential(ctx context.Context, plan *credentialMTLSResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	credential.MTLSClientCertificate = plan.Certificate.ValueString()
+	credential.MTLSRootCertificate = plan.CACertificate.ValueString()
+
+	return diags
+}
+
+func (r *credentialMTLSResource) convertMTLSCredentialToPlan(ctx context.Context, plan *credentialMTLSResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.Certificate = types.StringValue(credential.MTLSClientCertificate)
+	if credential.MTLSRootCertificate != "" {
+		plan.CACertificate = types.StringValue(credential.MTLSRootCertificate)
+	} else {
+		plan.CACertificate = types.StringNull()
+	}
+
+	cert, err := parseCertificate(credential.MTLSClientCertificate)
+	if err != nil {
+		diags.AddError(
+			"Invalid Certificate",
+			"Could not parse the client certificate returned by Tines, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	plan.ExpiresAt = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	plan.Subject = types.StringValue(cert.Subject.String())
+
+	return diags
+}
+
+// Parses the first certificate of a PEM-encoded certificate chain.
+func parseCertificate(certificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM-encoded certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// Maps the keys supported in test_value to the secret fields of an mTLS Credential.
+func mtlsTestValueFields(credential *tines.Credential) map[string]*string {
+	return map[string]*string{
+		"private_key": &credential.MTLSPrivateKey,
+	}
+}
+
+// Sets the secret fields of the test version of an mTLS Credential from test_value.
+func setMTLSTestValue(credential *tines.Credential, values map[string]string) diag.Diagnostics {
+	return setTestValueFields(values, mtlsTestValueFields(credential))
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTinesCredentialMTLS_basic(t *testing.T) {
+	certificate, privateKey := testAccGenerateCertificate(t, 365*24*time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Invalid certificates are rejected at plan time.
+				Config:      providerConfig + testAccCreateTinesCredentialMTLS("not a certificate", privateKey, 1),
+				ExpectError: regexp.MustCompile("Invalid Certificate"),
+			},
+			{
+				// Create the Tines Credential.
+				Config: providerConfig + testAccCreateTinesCredentialMTLS(certificate, privateKey, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectKnownValue(
+							"tines_credential_mtls.test_mtls_credential",
+							tfjsonpath.New("subject"),
+							knownvalue.StringExact("CN=terraform-test"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_mtls.test_mtls_credential",
+						tfjsonpath.New("private_key"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Rotate the private key by bumping private_key_version.
+				Config: providerConfig + testAccCreateTinesCredentialMTLS(certificate, privateKey, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_mtls.test_mtls_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Add a CA certificate to verify the server. The client certificate is self-signed, so it is its own CA.
+				Config: providerConfig + testAccCreateTinesCredentialMTLSWithCA(certificate, privateKey, certificate),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_mtls.test_mtls_credential",
+						tfjsonpath.New("ca_certificate"),
+						knownvalue.StringExact(certificate),
+					),
+				},
+			},
+			{
+				// Removing the CA certificate clears it from the Tines Credential.
+				Config: providerConfig + testAccCreateTinesCredentialMTLS(certificate, privateKey, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_mtls.test_mtls_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_mtls.test_mtls_credential",
+						tfjsonpath.New("ca_certificate"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Import the existing Tines Credential.
+				ResourceName:            "tines_credential_mtls.test_mtls_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key_version"},
+			},
+		},
+	})
+}
+
+func TestAccTinesCredentialMTLS_expiryWarning(t *testing.T) {
+	certificate, privateKey := testAccGenerateCertificate(t, 24*time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A certificate that expires within the default 30 days only raises a warning,
+				// so the credential is still created.
+				Config: providerConfig + testAccCreateTinesCredentialMTLS(certificate, privateKey, 1),
+				Check: func(*terraform.State) error {
+					return testAccCheckMTLSExpiryWarning(certificate, 30, true)
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_mtls.test_mtls_credential",
+						tfjsonpath.New("expiry_warning_days"),
+						knownvalue.Int64Exact(30),
+					),
+				},
+			},
+			{
+				// Disabling the warning updates the credential in place.
+				Config: providerConfig + testAccCreateTinesCredentialMTLSWithExpiryWarning(certificate, privateKey, 0),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_mtls.test_mtls_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(*terraform.State) error {
+					return testAccCheckMTLSExpiryWarning(certificate, 0, false)
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_mtls.test_mtls_credential",
+						tfjsonpath.New("expiry_warning_days"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestCredentialMTLSResource_expiryWarningDays(t *testing.T) {
+	certificate, _ := testAccGenerateCertificate(t, 10*24*time.Hour)
+
+	// The certificate expires in 10 days, so only a warning window longer than that warns.
+	for warningDays, expectWarning := range map[int64]bool{0: false, 7: false, 14: true, 30: true} {
+		if err := testAccCheckMTLSExpiryWarning(certificate, warningDays, expectWarning); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// Runs the plan modifier of the mTLS credential resource against the given certificate, and checks
+// whether the expiry warning is shown. Warnings can't be asserted through the Terraform CLI.
+func testAccCheckMTLSExpiryWarning(certificate string, warningDays int64, expectWarning bool) error {
+	ctx := context.Background()
+	r := &credentialMTLSResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("certificate"), certificate)
+	diags.Append(plan.SetAttribute(ctx, path.Root("expiry_warning_days"), warningDays)...)
+	if diags.HasError() {
+		return fmt.Errorf("could not build plan: %v", diags)
+	}
+
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		return fmt.Errorf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+
+	warned := false
+	for _, warning := range resp.Diagnostics.Warnings() {
+		if warning.Summary() == "Certificate Expiring Soon" {
+			warned = true
+		}
+	}
+	if warned != expectWarning {
+		return fmt.Errorf("expected expiry warning %t with expiry_warning_days = %d, got %t", expectWarning, warningDays, warned)
+	}
+
+	return nil
+}
+
+func testAccCreateTinesCredentialMTLS(certificate string, privateKey string, version int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_mtls" "test_mtls_credential" {
+	team_id = 30906
+	name = "Terraform Test mTLS Credential"
+	certificate = %q
+	private_key = %q
+	private_key_version = %d
+}
+	`, certificate, privateKey, version)
+}
+
+func testAccCreateTinesCredentialMTLSWithCA(certificate string, privateKey string, caCertificate string) string {
+	return fmt.Sprintf(`
+resource "tines_credential_mtls" "test_mtls_credential" {
+	team_id = 30906
+	name = "Terraform Test mTLS Credential"
+	certificate = %q
+	private_key = %q
+	private_key_version = 2
+	ca_certificate = %q
+}
+	`, certificate, privateKey, caCertificate)
+}
+
+func testAccCreateTinesCredentialMTLSWithExpiryWarning(certificate string, privateKey string, warningDays int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_mtls" "test_mtls_credential" {
+	team_id = 30906
+	name = "Terraform Test mTLS Credential"
+	certificate = %q
+	private_key = %q
+	private_key_version = 1
+	expiry_warning_days = %d
+}
+	`, certificate, privateKey, warningDays)
+}
+
+// Generates a self-signed PEM-encoded certificate and private key that expire after the given duration.
+func testAccGenerateCertificate(t *testing.T, validFor time.Duration) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(validFor),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return string(certificate), string(privateKey)
+}
@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialOAuthResource is the resource implementation.
+type credentialOAuthResource struct {
+	credentialResource
+}
+
+type credentialOAuthResourceModel struct {
+	credentialResourceModel
+	GrantType           types.String `tfsdk:"grant_type"`
+	AuthorizationURL    types.String `tfsdk:"authorization_url"`
+	TokenURL            types.String `tfsdk:"token_url"`
+	Scopes              types.List   `tfsdk:"scopes"`
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	ClientSecretVersion types.Int64  `tfsdk:"client_secret_version"`
+	PKCEEnabled         types.Bool   `tfsdk:"pkce_enabled"`
+	TokenRefreshEnabled types.Bool   `tfsdk:"token_refresh_enabled"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &credentialOAuthResource{}
+	_ resource.ResourceWithConfigure      = &credentialOAuthResource{}
+	_ resource.ResourceWithImportState    = &credentialOAuthResource{}
+	_ resource.ResourceWithValidateConfig = &credentialOAuthResource{}
+)
+
+// NewCredentialOAuthResource is a helper function to simplify the provider implementation.
+func NewCredentialOAuthResource() resource.Resource {
+	return &credentialOAuthResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *credentialOAuthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_oauth"
+}
+
+const CREDENTIAL_OAUTH_RESOURCE_DESCRIPTION = `
+A Tines OAuth 2.0 Credential fetches and refreshes access tokens using either the client credentials or the authorization
+code grant. The client secret is a write-only attribute: it is sent to Tines but never stored in the Terraform plan or
+state. To rotate it, update it together with client_secret_version. Write-only attributes require Terraform 1.11 or later.
+Credentials using the authorization code grant must still be connected by a user in the Tines UI after they are created.`
+
+// Schema defines the schema for the resource.
+func (r *credentialOAuthResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := credentialResourceAttributes("The only supported key is client_secret.")
+	attributes["grant_type"] = schema.StringAttribute{
+		Description: "The OAuth 2.0 grant type used to fetch tokens (client_credentials, authorization_code).",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("client_credentials", "authorization_code"),
+		},
+	}
+	attributes["authorization_url"] = schema.StringAttribute{
+		Description: "The URL users are sent to in order to authorize Tines. Required when grant_type is authorization_code.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["token_url"] = schema.StringAttribute{
+		Description: "The URL used to fetch and refresh access tokens.",
+		Required:    true,
+	}
+	attributes["scopes"] = schema.ListAttribute{
+		Description: "The list of scopes to request.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+	attributes["client_id"] = schema.StringAttribute{
+		Description: "The client ID of the OAuth application.",
+		Required:    true,
+	}
+	attributes["client_secret"] = schema.StringAttribute{
+		Description: "The client secret of the OAuth application. Required when grant_type is client_credentials. This value is never stored in Terraform state.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["client_secret_version"] = schema.Int64Attribute{
+		Description: "An arbitrary version number for client_secret. The secret is only sent to Tines on create, or when this version changes.",
+		Optional:    true,
+	}
+	attributes["pkce_enabled"] = schema.BoolAttribute{
+		Description: "Boolean flag indicating whether Proof Key for Code Exchange (PKCE) is used with the authorization code grant.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+	attributes["token_refresh_enabled"] = schema.BoolAttribute{
+		Description: "Boolean flag indicating whether Tines refreshes the access token automatically before it expires.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(true),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_OAUTH_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig checks that the attributes required by the configured grant type are set.
+func (r *credentialOAuthResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Reject unsupported test_value keys before any change is made in Tines.
+	resp.Diagnostics.Append(validateTestValue(ctx, req.Config, oauthTestValueFields(&tines.Credential{}))...)
+
+	var config credentialOAuthResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.GrantType.ValueString() == "authorization_code" && config.AuthorizationURL.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("authorization_url"),
+			"Missing Authorization URL",
+			"The authorization_url attribute must be set when grant_type is authorization_code.",
+		)
+	}
+
+	if config.GrantType.ValueString() == "client_credentials" && config.ClientSecret.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing Client Secret",
+			"The client_secret attribute must be set when grant_type is client_credentials.",
+		)
+	}
+
+	if config.GrantType.ValueString() == "client_credentials" && config.PKCEEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pkce_enabled"),
+			"Invalid PKCE Configuration",
+			"PKCE can only be enabled when grant_type is authorization_code.",
+		)
+	}
+}
+
+// Create creates a new Tines Credential and sets the initial Terraform state.
+func (r *credentialOAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines OAuth Credential")
+
+	var plan credentialOAuthResourceModel
+	var clientSecret types.String
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	diags = req.Config.GetAttribute(ctx, path.Root("client_secret"), &clientSecret)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCredential := tines.Credential{
+		Mode:              "OAUTH",
+		OAuthClientSecret: clientSecret.ValueString(),
+	}
+
+	diags = r.convertPlanToOAuthCredential(ctx, &plan, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateCredential(ctx, &newCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertOAuthCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the test version of the Tines Credential, if one is configured.
+	var testCredential tines.Credential
+	diags = r.convertPlanToOAuthCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "OAUTH"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, true, setOAuthTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *credentialOAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState credentialOAuthResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, diags := r.readCredential(ctx, localState.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remoteState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = r.convertOAuthCredentialToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Credential and sets the updated Terraform state on success.
+func (r *credentialOAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines OAuth Credential")
+
+	var plan, state credentialOAuthResourceModel
+	var credentialUpdate tines.Credential
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToOAuthCredential(ctx, &plan, &credentialUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client secret is only replaced when client_secret_version changes, since
+	// Terraform cannot detect changes to a write-only value on its own.
+	if !plan.ClientSecretVersion.Equal(state.ClientSecretVersion) {
+		var clientSecret types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("client_secret"), &clientSecret)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		credentialUpdate.OAuthClientSecret = clientSecret.ValueString()
+	}
+
+	// The test value is sent when test_value_version changes, or when the test credential
+	// doesn't exist yet.
+	sendTestValue := !plan.TestVersion.Equal(state.TestVersion) || state.TestCredID.IsNull()
+
+	credential, err := r.client.UpdateCredential(ctx, int(plan.ID.ValueInt64()), &credentialUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Credential",
+			"Could not update credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertOAuthCredentialToPlan(ctx, &plan, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the test version of the Tines Credential in sync with the live credential.
+	var testCredential tines.Credential
+	diags = r.convertPlanToOAuthCredential(ctx, &plan, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "OAUTH"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, sendTestValue, setOAuthTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *credentialOAuthResource) convertPlanToOAuthCredential(ctx context.Context, plan *credentialOAuthResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	credential.OAuthGrantType = plan.GrantType.ValueString()
+	credential.OAuthURL = plan.AuthorizationURL.ValueString()
+	credential.OAuthTokenURL = plan.TokenURL.ValueString()
+	credential.OAuthClientID = plan.ClientID.ValueString()
+	credential.OAuthPKCEEnabled = plan.PKCEEnabled.ValueBool()
+	credential.OAuthTokenRefreshEnabled = plan.TokenRefreshEnabled.ValueBool()
+
+	// The Tines API expects scopes as a single space-separated string.
+	if !plan.Scopes.IsNull() && !plan.Scopes.IsUnknown() {
+		var scopes []string
+		diags = plan.Scopes.ElementsAs(ctx, &scopes, false)
+		if diags.HasError() {
+			return diags
+		}
+		credential.OAuthScope = strings.Join(scopes, " ")
+	}
+
+	return diags
+}
+
+func (r *credentialOAuthResource) convertOAuthCredentialToPlan(ctx context.Context, plan *credentialOAuthResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.GrantType = types.StringValue(credential.OAuthGrantType)
+	plan.TokenURL = types.StringValue(credential.OAuthTokenURL)
+
+	if credential.OAuthURL != "" {
+		plan.AuthorizationURL = types.StringValue(credential.OAuthURL)
+	} else {
+		plan.AuthorizationURL = types.StringNull()
+	}
+
+	if credential.OAuthScope != "" {
+		plan.Scopes, diags = types.ListValueFrom(ctx, types.StringType, strings.Fields(credential.OAuthScope))
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		plan.Scopes = types.ListNull(types.StringType)
+	}
+	plan.ClientID = types.StringValue(credential.OAuthClientID)
+	plan.PKCEEnabled = types.BoolValue(credential.OAuthPKCEEnabled)
+	plan.TokenRefreshEnabled = types.BoolValue(credential.OAuthTokenRefreshEnabled)
+
+	return diags
+}
+
+// Maps the keys supported in test_value to the secret fields of an OAuth Credential.
+func oauthTestValueFields(credential *tines.Credential) map[string]*string {
+	return map[string]*string{
+		"client_secret": &credential.OAuthClientSecret,
+	}
+}
+
+// Sets the secret fields of the test version of an OAuth Credential from test_value.
+func setOAuthTestValue(credential *tines.Credential, values map[string]string) diag.Diagnostics {
+	return setTestValueFields(values, oauthTestValueFields(credential))
+}
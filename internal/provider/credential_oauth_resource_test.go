@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTinesCredentialOAuth_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the Tines Credential.
+				Config: providerConfig + testAccCreateTinesCredentialOAuth("first secret", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_credential_oauth.test_oauth_credential",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_oauth.test_oauth_credential",
+						tfjsonpath.New("client_secret"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_oauth.test_oauth_credential",
+						tfjsonpath.New("scopes"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("read"),
+							knownvalue.StringExact("write"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_oauth.test_oauth_credential",
+						tfjsonpath.New("token_refresh_enabled"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				// Rotate the client secret by bumping client_secret_version.
+				Config: providerConfig + testAccCreateTinesCredentialOAuth("second secret", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_oauth.test_oauth_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Removing the scopes clears them in Tines.
+				Config: providerConfig + `
+resource "tines_credential_oauth" "test_oauth_credential" {
+	team_id = 30906
+	name = "Terraform Test OAuth Credential"
+	grant_type = "client_credentials"
+	token_url = "https://example.com/oauth/token"
+	client_id = "terraform-test"
+	client_secret = "second secret"
+	client_secret_version = 2
+}
+	`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_oauth.test_oauth_credential",
+						tfjsonpath.New("scopes"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Import the existing Tines Credential.
+				ResourceName:            "tines_credential_oauth.test_oauth_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret_version"},
+			},
+		},
+	})
+}
+
+func TestAccTinesCredentialOAuth_missingClientSecret(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tines_credential_oauth" "test_oauth_credential" {
+	team_id = 30906
+	name = "Terraform Test OAuth Credential"
+	grant_type = "client_credentials"
+	token_url = "https://example.com/oauth/token"
+	client_id = "terraform-test"
+}
+	`,
+				ExpectError: regexp.MustCompile("Missing Client Secret"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCredentialOAuth(secret string, version int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_oauth" "test_oauth_credential" {
+	team_id = 30906
+	name = "Terraform Test OAuth Credential"
+	grant_type = "client_credentials"
+	token_url = "https://example.com/oauth/token"
+	scopes = ["read", "write"]
+	client_id = "terraform-test"
+	client_secret = %q
+	client_secret_version = %d
+}
+	`, secret, version)
+}
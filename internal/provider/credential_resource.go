@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialResource holds the behaviour shared by every tines_credential_* resource.
+// Each credential type embeds it and implements its own Schema, Create, Read and Update
+// methods, since the secret attributes differ between credential types.
+type credentialResource struct {
+	client *tines.Client
+}
+
+// credentialResourceModel describes the attributes shared by every tines_credential_* resource.
+// It is embedded in the model of each credential type.
+type credentialResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	TeamID          types.Int64  `tfsdk:"team_id"`
+	FolderID        types.Int64  `tfsdk:"folder_id"`
+	ReadAccess      types.String `tfsdk:"read_access"`
+	SharedTeamSlugs types.List   `tfsdk:"shared_team_slugs"`
+	AllowedHosts    types.List   `tfsdk:"allowed_hosts"`
+	Slug            types.String `tfsdk:"slug"`
+	RefActions      types.List   `tfsdk:"referencing_action_ids"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	TestEnabled     types.Bool   `tfsdk:"test_credential_enabled"`
+	TestValue       types.Map    `tfsdk:"test_value"`
+	TestVersion     types.Int64  `tfsdk:"test_value_version"`
+	TestCredID      types.Int64  `tfsdk:"test_credential_id"`
+}
+
+// credentialResourceAttributes returns the schema attributes shared by every tines_credential_*
+// resource. A new map is returned on each call so that callers can add their own attributes.
+// testValueDescription documents which keys the test_value map accepts for the credential type.
+func credentialResourceAttributes(testValueDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The Tines-generated identifier for this Tines Credential.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the Tines Credential.",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A long-form description of the Tines Credential.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("Managed via Terraform"),
+		},
+		"team_id": schema.Int64Attribute{
+			Description: "The ID of the Tines Team where this Tines Credential will be located.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"folder_id": schema.Int64Attribute{
+			Description: "The ID of the folder where this Tines Credential will be located.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"read_access": schema.StringAttribute{
+			Description: "Controls who is allowed to use this Tines Credential (TEAM, GLOBAL, SPECIFIC_TEAMS). default: TEAM.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("TEAM", "GLOBAL", "SPECIFIC_TEAMS"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"shared_team_slugs": schema.ListAttribute{
+			Description: "List of teams' slugs where this Tines Credential can be used. Required to set read_access to SPECIFIC_TEAMS.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("read_access")),
+			},
+		},
+		"allowed_hosts": schema.ListAttribute{
+			Description: "List of domains that this Tines Credential may be sent to. If empty, the credential can be used with any domain.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"slug": schema.StringAttribute{
+			Description: "An underscored representation of the Tines Credential name, as used in formulas.",
+			Computed:    true,
+		},
+		"referencing_action_ids": schema.ListAttribute{
+			Description: "A list of Action IDs in Tines Stories that reference this Tines Credential. This Credential should not be removed if this is non-null.",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The ISO 8601 Timestamp representing date and time the Tines Credential was created.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The ISO 8601 Timestamp representing date and time the Tines Credential was last updated.",
+			Computed:    true,
+		},
+		"test_credential_enabled": schema.BoolAttribute{
+			Description: "A boolean value indicating whether the Tines Credential is enabled for using a test Tines Credential value during non-production Story execution.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"test_value": schema.MapAttribute{
+			Description: "The secret values of the test version of this Tines Credential. " + testValueDescription + " This value is never stored in Terraform state.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.Map{
+				mapvalidator.AlsoRequires(path.MatchRoot("test_credential_enabled")),
+			},
+		},
+		"test_value_version": schema.Int64Attribute{
+			Description: "An arbitrary version number for test_value. The test value is only sent to Tines on create, or when this version changes.",
+			Optional:    true,
+		},
+		"test_credential_id": schema.Int64Attribute{
+			Description: "The Tines-generated identifier for the test version of this Tines Credential.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// Delete deletes the Tines Credential and removes the Terraform state on success.
+func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Credential")
+
+	// Retrieve the ID from state. The rest of the model differs between credential types.
+	var id types.Int64
+	diags := req.State.GetAttribute(ctx, path.Root("id"), &id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing Tines Credential.
+	err := r.client.DeleteCredential(ctx, int(id.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Credential",
+			"Could not delete Tines Credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Credential")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *credentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// readCredential retrieves the Tines Credential for the Read method of each credential type. A nil
+// credential without diagnostics means the credential no longer exists and should be recreated.
+func (r *credentialResource) readCredential(ctx context.Context, id types.Int64) (*tines.Credential, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	credential, err := r.client.GetCredential(ctx, int(id.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				return nil, diags
+			}
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+	}
+
+	return credential, diags
+}
+
+// Sets the shared attributes of an API request body. Optional values are only set when they
+// have been explicitly configured, so we don't unintentionally reset them to a default.
+func convertPlanToCredential(ctx context.Context, plan *credentialResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	credential.Name = plan.Name.ValueString()
+	credential.Description = plan.Description.ValueString()
+	credential.TeamID = int(plan.TeamID.ValueInt64())
+
+	if !plan.FolderID.IsNull() && !plan.FolderID.IsUnknown() {
+		credential.FolderID = int(plan.FolderID.ValueInt64())
+	}
+
+	if !plan.ReadAccess.IsNull() && !plan.ReadAccess.IsUnknown() {
+		credential.ReadAccess = plan.ReadAccess.ValueString()
+	}
+
+	if !plan.SharedTeamSlugs.IsNull() && !plan.SharedTeamSlugs.IsUnknown() {
+		diags = plan.SharedTeamSlugs.ElementsAs(ctx, &credential.SharedTeamSlugs, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !plan.AllowedHosts.IsNull() && !plan.AllowedHosts.IsUnknown() {
+		diags = plan.AllowedHosts.ElementsAs(ctx, &credential.AllowedHosts, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !plan.TestEnabled.IsNull() && !plan.TestEnabled.IsUnknown() {
+		credential.TestCredentialEnabled = plan.TestEnabled.ValueBool()
+	}
+
+	return diags
+}
+
+// Populates the shared attributes of a credential model from an API response.
+func convertCredentialToPlan(ctx context.Context, plan *credentialResourceModel, credential *tines.Credential) (diags diag.Diagnostics) {
+	plan.ID = types.Int64Value(int64(credential.ID))
+	plan.Name = types.StringValue(credential.Name)
+	plan.Description = types.StringValue(credential.Description)
+	plan.TeamID = types.Int64Value(int64(credential.TeamID))
+	plan.FolderID = types.Int64Value(int64(credential.FolderID))
+	plan.ReadAccess = types.StringValue(credential.ReadAccess)
+	plan.SharedTeamSlugs, diags = types.ListValueFrom(ctx, types.StringType, credential.SharedTeamSlugs)
+	if diags.HasError() {
+		return diags
+	}
+	plan.AllowedHosts, diags = types.ListValueFrom(ctx, types.StringType, credential.AllowedHosts)
+	if diags.HasError() {
+		return diags
+	}
+	plan.Slug = types.StringValue(credential.Slug)
+	plan.RefActions, diags = types.ListValueFrom(ctx, types.Int64Type, credential.ReferencingActionIDs)
+	if diags.HasError() {
+		return diags
+	}
+	plan.CreatedAt = types.StringValue(credential.CreatedAt)
+	plan.UpdatedAt = types.StringValue(credential.UpdatedAt)
+	plan.TestEnabled = types.BoolValue(credential.TestCredentialEnabled)
+	// The Tines API won't return a test credential if one has never been created.
+	if credential.TestCredentialID != 0 {
+		plan.TestCredID = types.Int64Value(int64(credential.TestCredentialID))
+	} else {
+		plan.TestCredID = types.Int64Null()
+	}
+
+	return diags
+}
+
+// syncTestCredential creates or updates the test version of a Tines Credential after the live
+// credential has been saved. The API does not permit creating a credential together with its
+// test version, so this is always a separate request. testCredential holds the non-secret
+// attributes of the credential type, and setTestValue copies the keys of test_value onto it.
+// The test value is only read from the configuration and sent to Tines when sendValue is set.
+func (r *credentialResource) syncTestCredential(ctx context.Context, config tfsdk.Config, plan *credentialResourceModel, testCredential tines.Credential, sendValue bool, setTestValue func(*tines.Credential, map[string]string) diag.Diagnostics) (diags diag.Diagnostics) {
+	if !plan.TestEnabled.ValueBool() {
+		return diags
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	var testValue types.Map
+	diags = config.GetAttribute(ctx, path.Root("test_value"), &testValue)
+	if diags.HasError() {
+		return diags
+	}
+
+	// There is nothing to keep in sync until a test value has been configured.
+	if testValue.IsNull() && plan.TestCredID.IsNull() {
+		return diags
+	}
+
+	testCredential.IsTest = true
+	testCredential.TestCredentialEnabled = false
+	testCredential.LiveCredentialID = int(plan.ID.ValueInt64())
+
+	if sendValue && !testValue.IsNull() && !testValue.IsUnknown() {
+		values := make(map[string]string)
+		diags = testValue.ElementsAs(ctx, &values, false)
+		if diags.HasError() {
+			return diags
+		}
+		diags = setTestValue(&testCredential, values)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	var credential *tines.Credential
+	var err error
+	if plan.TestCredID.IsNull() || plan.TestCredID.IsUnknown() {
+		tflog.Info(ctx, "Creating Tines Test Credential")
+		credential, err = r.client.CreateCredential(ctx, &testCredential)
+	} else {
+		tflog.Info(ctx, "Updating Tines Test Credential")
+		credential, err = r.client.UpdateCredential(ctx, int(plan.TestCredID.ValueInt64()), &testCredential)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Saving Tines Test Credential",
+			"Could not save test version of credential, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.TestCredID = types.Int64Value(int64(credential.ID))
+
+	return diags
+}
+
+// validateTestValue checks that every key of test_value is one of the given fields, so that
+// unsupported keys are rejected when planning rather than after the live credential has changed.
+func validateTestValue(ctx context.Context, config tfsdk.Config, fields map[string]*string) (diags diag.Diagnostics) {
+	var testValue types.Map
+	diags = config.GetAttribute(ctx, path.Root("test_value"), &testValue)
+	if diags.HasError() || testValue.IsNull() || testValue.IsUnknown() {
+		return diags
+	}
+
+	supported := slices.Sorted(maps.Keys(fields))
+	for _, key := range slices.Sorted(maps.Keys(testValue.Elements())) {
+		if _, ok := fields[key]; !ok {
+			diags.AddAttributeError(
+				path.Root("test_value").AtMapKey(key),
+				"Invalid Test Value",
+				fmt.Sprintf("The key %q is not supported in test_value for this credential type. Supported keys: %s.", key, strings.Join(supported, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// setTestValueFields copies the keys of test_value onto the given credential fields. The keys
+// have already been checked by validateTestValue, so unsupported keys are ignored.
+func setTestValueFields(values map[string]string, fields map[string]*string) diag.Diagnostics {
+	for key, value := range values {
+		if field, ok := fields[key]; ok {
+			*field = value
+		}
+	}
+
+	return nil
+}
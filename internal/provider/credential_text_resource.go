@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialTextResource is the resource implementation.
+type credentialTextResource struct {
+	credentialResource
+}
+
+type credentialTextResourceModel struct {
+	credentialResourceModel
+	Value        types.String `tfsdk:"value"`
+	ValueVersion types.Int64  `tfsdk:"value_version"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &credentialTextResource{}
+	_ resource.ResourceWithConfigure      = &credentialTextResource{}
+	_ resource.ResourceWithImportState    = &credentialTextResource{}
+	_ resource.ResourceWithValidateConfig = &credentialTextResource{}
+)
+
+// NewCredentialTextResource is a helper function to simplify the provider implementation.
+func NewCredentialTextResource() resource.Resource {
+	return &credentialTextResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *credentialTextResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_text"
+}
+
+const CREDENTIAL_TEXT_RESOURCE_DESCRIPTION = `
+A Tines Text Credential stores a secret string such as a password or an API key. The value is a write-only attribute:
+it is sent to Tines but never stored in the Terraform plan or state, so changes to it cannot be detected. To rotate the
+value, update it together with value_version. Write-only attributes require Terraform 1.11 or later.`
+
+// Schema defines the schema for the resource.
+func (r *credentialTextResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := credentialResourceAttributes("The only supported key is value.")
+	attributes["value"] = schema.StringAttribute{
+		Description: "The secret value of the Tines Credential. This value is never stored in Terraform state.",
+		Required:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes["value_version"] = schema.Int64Attribute{
+		Description: "An arbitrary version number for value. The value is only sent to Tines on create, or when this version changes.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: CREDENTIAL_TEXT_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig checks the keys of test_value, which can't be described by the schema.
+func (r *credentialTextResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateTestValue(ctx, req.Config, textTestValueFields(&tines.Credential{}))...)
+}
+
+// Create creates a new Tines Credential and sets the initial Terraform state.
+func (r *credentialTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Text Credential")
+
+	var plan credentialTextResourceModel
+	var value types.String
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	diags = req.Config.GetAttribute(ctx, path.Root("value"), &value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newCredential := tines.Credential{
+		Mode:  "TEXT",
+		Value: value.ValueString(),
+	}
+
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, &newCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, err := r.client.CreateCredential(ctx, &newCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Credential",
+			"Could not create credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the test version of the Tines Credential, if one is configured.
+	var testCredential tines.Credential
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "TEXT"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, true, setTextTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *credentialTextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState credentialTextResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, diags := r.readCredential(ctx, localState.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remoteState == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = convertCredentialToPlan(ctx, &localState.credentialResourceModel, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Credential and sets the updated Terraform state on success.
+func (r *credentialTextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Text Credential")
+
+	var plan, state credentialTextResourceModel
+	var credentialUpdate tines.Credential
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, &credentialUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The stored value is only replaced when value_version changes, since Terraform
+	// cannot detect changes to a write-only value on its own.
+	if !plan.ValueVersion.Equal(state.ValueVersion) {
+		var value types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("value"), &value)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		credentialUpdate.Value = value.ValueString()
+	}
+
+	// The test value is sent when test_value_version changes, or when the test credential
+	// doesn't exist yet.
+	sendTestValue := !plan.TestVersion.Equal(state.TestVersion) || state.TestCredID.IsNull()
+
+	credential, err := r.client.UpdateCredential(ctx, int(plan.ID.ValueInt64()), &credentialUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Credential",
+			"Could not update credential, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = convertCredentialToPlan(ctx, &plan.credentialResourceModel, credential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the test version of the Tines Credential in sync with the live credential.
+	var testCredential tines.Credential
+	diags = convertPlanToCredential(ctx, &plan.credentialResourceModel, &testCredential)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	testCredential.Mode = "TEXT"
+
+	diags = r.syncTestCredential(ctx, req.Config, &plan.credentialResourceModel, testCredential, sendTestValue, setTextTestValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Maps the keys supported in test_value to the secret fields of a Text Credential.
+func textTestValueFields(credential *tines.Credential) map[string]*string {
+	return map[string]*string{
+		"value": &credential.Value,
+	}
+}
+
+// Sets the secret fields of the test version of a Text Credential from test_value.
+func setTextTestValue(credential *tines.Credential, values map[string]string) diag.Diagnostics {
+	return setTestValueFields(values, textTestValueFields(credential))
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTinesCredentialText_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the Tines Credential.
+				Config: providerConfig + testAccCreateTinesCredentialText("first secret", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_credential_text.test_text_credential",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_text.test_text_credential",
+						tfjsonpath.New("value"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_text.test_text_credential",
+						tfjsonpath.New("slug"),
+						knownvalue.StringExact("terraform_test_text_credential"),
+					),
+				},
+			},
+			{
+				// Changing only the write-only value must not produce a plan.
+				Config: providerConfig + testAccCreateTinesCredentialText("second secret", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Rotate the value by bumping value_version.
+				Config: providerConfig + testAccCreateTinesCredentialText("second secret", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_text.test_text_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Import the existing Tines Credential.
+				ResourceName:            "tines_credential_text.test_text_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value_version"},
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCredentialText(value string, version int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_text" "test_text_credential" {
+	team_id = 30906
+	name = "Terraform Test Text Credential"
+	value = %q
+	value_version = %d
+}
+	`, value, version)
+}
+
+func TestAccTinesCredentialText_testValue(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Write-only attributes are only supported from Terraform 1.11 onwards.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the Tines Credential together with its test version.
+				Config: providerConfig + testAccCreateTinesCredentialTextWithTestValue("test secret", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_credential_text.test_text_credential",
+						tfjsonpath.New("test_credential_enabled"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_text.test_text_credential",
+						tfjsonpath.New("test_credential_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_credential_text.test_text_credential",
+						tfjsonpath.New("test_value"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				// Rotate the test value by bumping test_value_version.
+				Config: providerConfig + testAccCreateTinesCredentialTextWithTestValue("new test secret", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_credential_text.test_text_credential", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Unsupported keys in the test value are rejected when planning, before the credential is changed.
+				Config: providerConfig + `
+resource "tines_credential_text" "test_text_credential" {
+	team_id = 30906
+	name = "Terraform Test Text Credential With Test Value"
+	value = "live secret"
+	test_credential_enabled = true
+	test_value = {
+		token = "new test secret"
+	}
+	test_value_version = 3
+}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Test Value"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesCredentialTextWithTestValue(testValue string, version int) string {
+	return fmt.Sprintf(`
+resource "tines_credential_text" "test_text_credential" {
+	team_id = 30906
+	name = "Terraform Test Text Credential With Test Value"
+	value = "live secret"
+	test_credential_enabled = true
+	test_value = {
+		value = %q
+	}
+	test_value_version = %d
+}
+	`, testValue, version)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// credentialsDataSource is the data source implementation.
+type credentialsDataSource struct {
+	client *tines.Client
+}
+
+type credentialsDataSourceModel struct {
+	TeamID      types.Int64           `tfsdk:"team_id"`
+	Credentials []credentialDataModel `tfsdk:"credentials"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &credentialsDataSource{}
+	_ datasource.DataSourceWithConfigure = &credentialsDataSource{}
+)
+
+// NewCredentialsDataSource is a helper function to simplify the provider implementation.
+func NewCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *credentialsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+const CREDENTIALS_DATA_SOURCE_DESCRIPTION = `
+Use this data source to list the metadata of all Tines Credentials visible to the API key, optionally limited to a
+single team. The secret values of the credentials are never returned.`
+
+// Schema defines the schema for the data source.
+func (d *credentialsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: CREDENTIALS_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int64Attribute{
+				Description: "Only return Tines Credentials located in the Tines Team with this ID.",
+				Optional:    true,
+			},
+			"credentials": schema.ListNestedAttribute{
+				Description: "The list of Tines Credentials.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: credentialDataAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *credentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Credentials")
+
+	var state credentialsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A team ID of 0 lists the credentials across every team.
+	credentials, err := d.client.ListCredentials(ctx, int(state.TeamID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tines Credentials",
+			"An unexpected error occurred while attempting to list credentials. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Credentials = []credentialDataModel{}
+	for i := range credentials {
+		var credential credentialDataModel
+		resp.Diagnostics.Append(convertCredentialToDataModel(ctx, &credential, &credentials[i])...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Credentials = append(state.Credentials, credential)
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *credentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
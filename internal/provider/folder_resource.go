@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// folderResource is the resource implementation.
+type folderResource struct {
+	client *tines.Client
+}
+
+type folderResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	TeamID      types.Int64  `tfsdk:"team_id"`
+	ContentType types.String `tfsdk:"content_type"`
+	Size        types.Int64  `tfsdk:"size"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &folderResource{}
+	_ resource.ResourceWithConfigure   = &folderResource{}
+	_ resource.ResourceWithImportState = &folderResource{}
+)
+
+// NewFolderResource is a helper function to simplify the provider implementation.
+func NewFolderResource() resource.Resource {
+	return &folderResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *folderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+const FOLDER_RESOURCE_DESCRIPTION = `
+A Tines Folder organizes stories, resources or credentials within a team. The folder ID can be passed to the folder_id
+attribute of other resources. A folder must be empty before it can be destroyed: move or destroy its contents first.`
+
+// Schema defines the schema for the resource.
+func (r *folderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: FOLDER_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the folder.",
+				Required:    true,
+			},
+			"team_id": schema.Int64Attribute{
+				Description: "The ID of the Tines Team where this folder will be located.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The type of content stored in the folder (STORY, RESOURCE, CREDENTIAL).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("STORY", "RESOURCE", "CREDENTIAL"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The number of items stored in the folder.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates a new Tines Folder and sets the initial Terraform state.
+func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Folder")
+
+	var plan folderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newFolder := tines.Folder{
+		Name:        plan.Name.ValueString(),
+		TeamID:      int(plan.TeamID.ValueInt64()),
+		ContentType: plan.ContentType.ValueString(),
+	}
+
+	folder, err := r.client.CreateFolder(ctx, &newFolder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Folder",
+			"Could not create folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertFolderToPlan(&plan, folder)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState folderResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetFolder(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.convertFolderToPlan(&localState, remoteState)
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update renames the Tines Folder in place and sets the updated Terraform state on success.
+func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Folder")
+
+	var plan folderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folderUpdate := tines.Folder{
+		Name: plan.Name.ValueString(),
+	}
+
+	folder, err := r.client.UpdateFolder(ctx, int(plan.ID.ValueInt64()), &folderUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Folder",
+			"Could not update folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertFolderToPlan(&plan, folder)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Folder and removes the Terraform state on success.
+func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Folder")
+
+	// Retrieve values from state
+	var state folderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the current contents of the folder first, so we can return an actionable
+	// error instead of the generic API response when the folder is not empty.
+	folder, err := r.client.GetFolder(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as the folder already being deleted.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Folder",
+			"Could not read folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if folder.Size > 0 {
+		resp.Diagnostics.AddError(
+			"Tines Folder Not Empty",
+			fmt.Sprintf("Folder %q still contains %d item(s) and cannot be deleted. "+
+				"Move its contents to another folder or destroy them before destroying this folder.", folder.Name, folder.Size),
+		)
+		return
+	}
+
+	// Delete existing folder.
+	err = r.client.DeleteFolder(ctx, folder.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Folder",
+			"Could not delete folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Folder")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *folderResource) convertFolderToPlan(plan *folderResourceModel, folder *tines.Folder) {
+	plan.ID = types.Int64Value(int64(folder.ID))
+	plan.Name = types.StringValue(folder.Name)
+	plan.TeamID = types.Int64Value(int64(folder.TeamID))
+	plan.ContentType = types.StringValue(folder.ContentType)
+	plan.Size = types.Int64Value(int64(folder.Size))
+}
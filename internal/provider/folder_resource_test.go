@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesFolder_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the folder.
+				Config: providerConfig + testAccCreateTinesFolder("Terraform Test Folder"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_folder.test_folder",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_folder.test_folder",
+						tfjsonpath.New("size"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+			},
+			{
+				// Rename the folder in place.
+				Config: providerConfig + testAccCreateTinesFolder("Terraform Test Folder Renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_folder.test_folder", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_folder.test_folder",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Terraform Test Folder Renamed"),
+					),
+				},
+			},
+			{
+				// Import the existing folder.
+				ResourceName:      "tines_folder.test_folder",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCreateTinesFolder(name string) string {
+	return `
+resource "tines_folder" "test_folder" {
+	team_id = 30906
+	name = "` + name + `"
+	content_type = "RESOURCE"
+}
+	`
+}
@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// linkResource is the resource implementation.
+type linkResource struct {
+	client *tines.Client
+}
+
+type linkResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	SourceActionID   types.Int64  `tfsdk:"source_action_id"`
+	ReceiverActionID types.Int64  `tfsdk:"receiver_action_id"`
+	StoryID          types.Int64  `tfsdk:"story_id"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &linkResource{}
+	_ resource.ResourceWithConfigure      = &linkResource{}
+	_ resource.ResourceWithImportState    = &linkResource{}
+	_ resource.ResourceWithValidateConfig = &linkResource{}
+	_ resource.ResourceWithModifyPlan     = &linkResource{}
+)
+
+// NewLinkResource is a helper function to simplify the provider implementation.
+func NewLinkResource() resource.Resource {
+	return &linkResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *linkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link"
+}
+
+const LINK_RESOURCE_DESCRIPTION = `
+A Tines Link connects two actions in the same story, so that events emitted by the source action are received by the
+receiver action. Together with tines_action, this allows a story graph to be built entirely from Terraform configuration.`
+
+// Schema defines the schema for the resource.
+func (r *linkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: LINK_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the link, in the format source_action_id:receiver_action_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_action_id": schema.Int64Attribute{
+				Description: "The ID of the action that emits events.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"receiver_action_id": schema.Int64Attribute{
+				Description: "The ID of the action that receives events.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story that both actions belong to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the link doesn't connect an action to itself.
+func (r *linkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config linkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SourceActionID.IsUnknown() || config.ReceiverActionID.IsUnknown() {
+		return
+	}
+
+	if config.SourceActionID.Equal(config.ReceiverActionID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("receiver_action_id"),
+			"Invalid Tines Link",
+			fmt.Sprintf("An action can't be linked to itself, but source_action_id and receiver_action_id are both %d.", config.SourceActionID.ValueInt64()),
+		)
+	}
+}
+
+// ModifyPlan checks that both actions belong to the same story, so that the error is
+// shown at plan time instead of when the link is created.
+func (r *linkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan linkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The actions may not have been created yet, in which case they are checked on apply.
+	if plan.SourceActionID.IsUnknown() || plan.ReceiverActionID.IsUnknown() {
+		return
+	}
+
+	// Both actions were already checked when the link was created, and changing either of
+	// them replaces the link, so there is nothing to check when they are unchanged.
+	if !req.State.Raw.IsNull() {
+		var state linkResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.SourceActionID.Equal(state.SourceActionID) && plan.ReceiverActionID.Equal(state.ReceiverActionID) {
+			return
+		}
+	}
+
+	// The provider may not be configured yet during validation.
+	if r.client == nil {
+		return
+	}
+
+	storyID, err := r.linkStoryID(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Tines Link",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("story_id"), storyID)...)
+}
+
+// Create creates a new Tines Link and sets the initial Terraform state.
+func (r *linkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Link")
+
+	var plan linkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storyID, err := r.linkStoryID(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Tines Link",
+			err.Error(),
+		)
+		return
+	}
+
+	newLink := tines.Link{
+		SourceID:   int(plan.SourceActionID.ValueInt64()),
+		ReceiverID: int(plan.ReceiverActionID.ValueInt64()),
+	}
+
+	link, err := r.client.CreateLink(ctx, &newLink)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Link",
+			"Could not create link, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", link.SourceID, link.ReceiverID))
+	plan.StoryID = types.Int64Value(int64(storyID))
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *linkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState linkResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Links don't exist on their own in the Tines API, so read the receivers of the source action.
+	source, err := r.client.GetAction(ctx, int(localState.SourceActionID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(source.ReceiverIDs, int(localState.ReceiverActionID.ValueInt64())) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	localState.ID = types.StringValue(fmt.Sprintf("%d:%d", source.ID, localState.ReceiverActionID.ValueInt64()))
+	localState.StoryID = types.Int64Value(int64(source.StoryID))
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, since every configurable attribute requires replacement.
+func (r *linkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error Updating Tines Link",
+		"Tines Links can't be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+// Delete deletes the Tines Link and removes the Terraform state on success.
+func (r *linkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Link")
+
+	// Retrieve values from state
+	var state linkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing link.
+	err := r.client.DeleteLink(ctx, int(state.SourceActionID.ValueInt64()), int(state.ReceiverActionID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Link",
+			"Could not delete link, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *linkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Link")
+	// Retrieve the action IDs from an import ID in the format source_action_id:receiver_action_id.
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Expected an import ID in the format source_action_id:receiver_action_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	sourceID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the source action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	receiverID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the receiver action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_action_id"), sourceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("receiver_action_id"), receiverID)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *linkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Looks up both actions of a link and returns the ID of the story they belong to.
+func (r *linkResource) linkStoryID(ctx context.Context, plan *linkResourceModel) (int, error) {
+	source, err := r.client.GetAction(ctx, int(plan.SourceActionID.ValueInt64()))
+	if err != nil {
+		return 0, fmt.Errorf("could not read source action %d, unexpected error: %w", plan.SourceActionID.ValueInt64(), err)
+	}
+
+	receiver, err := r.client.GetAction(ctx, int(plan.ReceiverActionID.ValueInt64()))
+	if err != nil {
+		return 0, fmt.Errorf("could not read receiver action %d, unexpected error: %w", plan.ReceiverActionID.ValueInt64(), err)
+	}
+
+	if source.StoryID != receiver.StoryID {
+		return 0, fmt.Errorf("actions can only be linked within the same story, but source action %d belongs to story %d "+
+			"and receiver action %d belongs to story %d", source.ID, source.StoryID, receiver.ID, receiver.StoryID)
+	}
+
+	return source.StoryID, nil
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesLink_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the link.
+				Config: providerConfig + testAccCreateTinesLinkActions() + `
+resource "tines_link" "test_link" {
+	source_action_id = tines_action.test_link_source.id
+	receiver_action_id = tines_action.test_link_receiver.id
+}
+				`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_link.test_link", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"tines_link.test_link",
+						tfjsonpath.New("story_id"),
+						"tines_story.test_link_story",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				// Import the existing link.
+				ResourceName:      "tines_link.test_link",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Actions in different stories can't be linked.
+				Config: providerConfig + testAccCreateTinesLinkActions() + `
+resource "tines_link" "test_link" {
+	source_action_id = tines_action.test_link_source.id
+	receiver_action_id = tines_action.test_link_other_story.id
+}
+				`,
+				ExpectError: regexp.MustCompile("actions can only be linked within the same story"),
+			},
+		},
+	})
+}
+
+func TestAccTinesLink_selfLink(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Linking an action to itself is rejected at plan time.
+				Config: providerConfig + `
+resource "tines_link" "test_link" {
+	source_action_id = 1
+	receiver_action_id = 1
+}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Tines Link"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesLinkActions() string {
+	return `
+resource "tines_story" "test_link_story" {
+	team_id = 30906
+	name = "Terraform Test Link Story"
+}
+
+resource "tines_story" "test_link_other_story" {
+	team_id = 30906
+	name = "Terraform Test Link Other Story"
+}
+
+resource "tines_action" "test_link_source" {
+	story_id = tines_story.test_link_story.id
+	type = "Agents::EventTransformationAgent"
+	name = "Terraform Test Link Source"
+	options = {
+		mode = "message_only"
+		payload = {
+			message = "hello"
+		}
+	}
+	position = {
+		x = 0
+		y = 0
+	}
+}
+
+resource "tines_action" "test_link_receiver" {
+	story_id = tines_story.test_link_story.id
+	type = "Agents::EventTransformationAgent"
+	name = "Terraform Test Link Receiver"
+	options = {
+		mode = "message_only"
+		payload = {
+			message = "world"
+		}
+	}
+	position = {
+		x = 0
+		y = 90
+	}
+}
+
+resource "tines_action" "test_link_other_story" {
+	story_id = tines_story.test_link_other_story.id
+	type = "Agents::EventTransformationAgent"
+	name = "Terraform Test Link Other Story"
+	options = {
+		mode = "message_only"
+		payload = {
+			message = "elsewhere"
+		}
+	}
+	position = {
+		x = 0
+		y = 0
+	}
+}
+`
+}
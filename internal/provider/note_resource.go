@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// noteResource is the resource implementation.
+type noteResource struct {
+	client *tines.Client
+}
+
+type noteResourceModel struct {
+	ID       types.Int64          `tfsdk:"id"`
+	StoryID  types.Int64          `tfsdk:"story_id"`
+	Content  types.String         `tfsdk:"content"`
+	Position *actionPositionModel `tfsdk:"position"`
+	Width    types.Int64          `tfsdk:"width"`
+	Color    types.String         `tfsdk:"color"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &noteResource{}
+	_ resource.ResourceWithConfigure   = &noteResource{}
+	_ resource.ResourceWithImportState = &noteResource{}
+)
+
+// NewNoteResource is a helper function to simplify the provider implementation.
+func NewNoteResource() resource.Resource {
+	return &noteResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *noteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_note"
+}
+
+const NOTE_RESOURCE_DESCRIPTION = `
+A Tines Note is a block of Markdown displayed on the storyboard of a Tines Story, such as runbook links or ownership
+information. Managing notes in Terraform keeps them consistent across many stories.`
+
+// Schema defines the schema for the resource.
+func (r *noteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: NOTE_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this note.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story that this note belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the note, in Markdown.",
+				Required:    true,
+			},
+			"position": schema.SingleNestedAttribute{
+				Description: "The position of the note on the storyboard.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"x": schema.Int64Attribute{
+						Description: "The horizontal position of the note.",
+						Required:    true,
+					},
+					"y": schema.Int64Attribute{
+						Description: "The vertical position of the note.",
+						Required:    true,
+					},
+				},
+			},
+			"width": schema.Int64Attribute{
+				Description: "The width of the note in pixels.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				Description: "The color of the note, such as white, gold or blue.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates a new Tines Note and sets the initial Terraform state.
+func (r *noteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Note")
+
+	var plan noteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newNote := tines.Note{
+		StoryID: int(plan.StoryID.ValueInt64()),
+	}
+	r.convertPlanToNote(&plan, &newNote)
+
+	note, err := r.client.CreateNote(ctx, &newNote)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Note",
+			"Could not create note, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertNoteToPlan(&plan, note)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *noteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState noteResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetNote(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.convertNoteToPlan(&localState, remoteState)
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Note and sets the updated Terraform state on success.
+func (r *noteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Note")
+
+	var plan noteResourceModel
+	var noteUpdate tines.Note
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.convertPlanToNote(&plan, &noteUpdate)
+
+	note, err := r.client.UpdateNote(ctx, int(plan.ID.ValueInt64()), &noteUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Note",
+			"Could not update note, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertNoteToPlan(&plan, note)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Note and removes the Terraform state on success.
+func (r *noteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Note")
+
+	// Retrieve values from state
+	var state noteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing note.
+	err := r.client.DeleteNote(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Note",
+			"Could not delete note, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *noteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Note")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Note, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *noteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Sets the attributes of an API request body. Optional values are only set when they
+// have been explicitly configured, so we don't unintentionally reset them to a default.
+func (r *noteResource) convertPlanToNote(plan *noteResourceModel, note *tines.Note) {
+	note.Content = plan.Content.ValueString()
+	note.Position = tines.Position{
+		X: int(plan.Position.X.ValueInt64()),
+		Y: int(plan.Position.Y.ValueInt64()),
+	}
+
+	if !plan.Width.IsNull() && !plan.Width.IsUnknown() {
+		note.Width = int(plan.Width.ValueInt64())
+	}
+
+	if !plan.Color.IsNull() && !plan.Color.IsUnknown() {
+		note.Color = plan.Color.ValueString()
+	}
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *noteResource) convertNoteToPlan(plan *noteResourceModel, note *tines.Note) {
+	plan.ID = types.Int64Value(int64(note.ID))
+	plan.StoryID = types.Int64Value(int64(note.StoryID))
+	plan.Content = types.StringValue(note.Content)
+	plan.Position = &actionPositionModel{
+		X: types.Int64Value(int64(note.Position.X)),
+		Y: types.Int64Value(int64(note.Position.Y)),
+	}
+	plan.Width = types.Int64Value(int64(note.Width))
+	plan.Color = types.StringValue(note.Color)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesNote_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the note.
+				Config: providerConfig + testAccCreateTinesNote("Managed by Terraform", "gold"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_note.test_note",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_note.test_note",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact("Managed by Terraform"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_note.test_note",
+						tfjsonpath.New("width"),
+						knownvalue.Int64Exact(280),
+					),
+				},
+			},
+			{
+				// Update the content and color in place.
+				Config: providerConfig + testAccCreateTinesNote("**Managed by Terraform**, do not edit.", "blue"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_note.test_note", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_note.test_note",
+						tfjsonpath.New("color"),
+						knownvalue.StringExact("blue"),
+					),
+				},
+			},
+			{
+				// Import the existing note.
+				ResourceName:      "tines_note.test_note",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCreateTinesNote(content string, color string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_note_story" {
+	team_id = 30906
+	name = "Terraform Test Note Story"
+}
+
+resource "tines_note" "test_note" {
+	story_id = tines_story.test_note_story.id
+	content = %q
+	position = {
+		x = -300
+		y = 0
+	}
+	width = 280
+	color = %q
+}
+	`, content, color)
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+	"github.com/tines/terraform-provider-tines/internal/utils"
+)
+
+// pageResource is the resource implementation.
+type pageResource struct {
+	client *tines.Client
+}
+
+type pageResourceModel struct {
+	ID             types.Int64   `tfsdk:"id"`
+	StoryID        types.Int64   `tfsdk:"story_id"`
+	Name           types.String  `tfsdk:"name"`
+	Elements       types.Dynamic `tfsdk:"elements"`
+	AccessLevel    types.String  `tfsdk:"access_level"`
+	AllowedDomains types.List    `tfsdk:"allowed_domains"`
+	URL            types.String  `tfsdk:"url"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pageResource{}
+	_ resource.ResourceWithConfigure   = &pageResource{}
+	_ resource.ResourceWithImportState = &pageResource{}
+)
+
+// NewPageResource is a helper function to simplify the provider implementation.
+func NewPageResource() resource.Resource {
+	return &pageResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *pageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page"
+}
+
+const PAGE_RESOURCE_DESCRIPTION = `
+A Tines Page is a form or web page that belongs to a Tines Story, such as an intake form. Managing pages in Terraform
+allows their access settings to be reviewed alongside the rest of the configuration.`
+
+// Schema defines the schema for the resource.
+func (r *pageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: PAGE_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this page.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story that this page belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the page.",
+				Required:    true,
+			},
+			"elements": schema.DynamicAttribute{
+				Description: "The elements of the page, such as headings and form fields, as a JSON array.",
+				Required:    true,
+			},
+			"access_level": schema.StringAttribute{
+				Description: "Controls who can open the page (PUBLIC, TENANT, TEAM). default: TEAM.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("TEAM"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("PUBLIC", "TENANT", "TEAM"),
+				},
+			},
+			"allowed_domains": schema.ListAttribute{
+				Description: "List of email domains whose users can open the page. If not set, users from any domain can open it.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the published page.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates a new Tines Page and sets the initial Terraform state.
+func (r *pageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Page")
+
+	var plan pageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newPage := tines.Page{
+		StoryID: int(plan.StoryID.ValueInt64()),
+	}
+
+	diags = r.convertPlanToPage(ctx, &plan, &newPage)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.CreatePage(ctx, &newPage)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Page",
+			"Could not create page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertPageToPlan(ctx, &plan, page)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *pageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState pageResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetPage(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := r.convertPageToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Page and sets the updated Terraform state on success.
+func (r *pageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Page")
+
+	var plan pageResourceModel
+	var pageUpdate tines.Page
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.convertPlanToPage(ctx, &plan, &pageUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.UpdatePage(ctx, int(plan.ID.ValueInt64()), &pageUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Page",
+			"Could not update page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertPageToPlan(ctx, &plan, page)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Page and removes the Terraform state on success.
+func (r *pageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Page")
+
+	// Retrieve values from state
+	var state pageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing page.
+	err := r.client.DeletePage(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Page",
+			"Could not delete page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *pageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Page")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Sets the attributes of an API request body. The story of a page can't be changed after
+// it is created, so it is set separately in Create.
+func (r *pageResource) convertPlanToPage(ctx context.Context, plan *pageResourceModel, page *tines.Page) (diags diag.Diagnostics) {
+	page.Name = plan.Name.ValueString()
+	page.AccessLevel = plan.AccessLevel.ValueString()
+
+	// Always send the list of domains, so that removing it from the configuration clears it.
+	page.AllowedDomains = []string{}
+	if !plan.AllowedDomains.IsNull() && !plan.AllowedDomains.IsUnknown() {
+		diags = plan.AllowedDomains.ElementsAs(ctx, &page.AllowedDomains, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	page.Elements, diags = utils.GetUnderlyingDynamicValue(ctx, &plan.Elements)
+
+	return diags
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *pageResource) convertPageToPlan(ctx context.Context, plan *pageResourceModel, page *tines.Page) (diags diag.Diagnostics) {
+	plan.ID = types.Int64Value(int64(page.ID))
+	plan.StoryID = types.Int64Value(int64(page.StoryID))
+	plan.Name = types.StringValue(page.Name)
+	plan.AccessLevel = types.StringValue(page.AccessLevel)
+	plan.URL = types.StringValue(page.URL)
+
+	if len(page.AllowedDomains) > 0 {
+		plan.AllowedDomains, diags = types.ListValueFrom(ctx, types.StringType, page.AllowedDomains)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		plan.AllowedDomains = types.ListNull(types.StringType)
+	}
+
+	plan.Elements, diags = utils.DynamicValueFromAny(ctx, page.Elements)
+
+	return diags
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesPage_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the page.
+				Config: providerConfig + testAccCreateTinesPage("TEAM"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_page.test_page",
+							tfjsonpath.New("url"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_page.test_page",
+						tfjsonpath.New("elements").AtSliceIndex(0).AtMapKey("type"),
+						knownvalue.StringExact("HEADING"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_page.test_page",
+						tfjsonpath.New("url"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				// Update the access level in place.
+				Config: providerConfig + testAccCreateTinesPage("TENANT"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_page.test_page", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_page.test_page",
+						tfjsonpath.New("access_level"),
+						knownvalue.StringExact("TENANT"),
+					),
+				},
+			},
+			{
+				// Import the existing page.
+				ResourceName:      "tines_page.test_page",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTinesPage_emptyAllowedDomains(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// An empty list is rejected, since the API doesn't distinguish it from an unset list.
+				Config: providerConfig + `
+resource "tines_page" "test_page" {
+	story_id = 1
+	name = "Terraform Test Page"
+	elements = []
+	allowed_domains = []
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesPage(accessLevel string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_page_story" {
+	team_id = 30906
+	name = "Terraform Test Page Story"
+}
+
+resource "tines_page" "test_page" {
+	story_id = tines_story.test_page_story.id
+	name = "Terraform Test Page"
+	elements = [
+		{
+			type = "HEADING"
+			content = "Terraform Test Page"
+		},
+	]
+	access_level = %q
+}
+	`, accessLevel)
+}
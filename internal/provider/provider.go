@@ -158,12 +158,39 @@ func (p *TinesProvider) Resources(ctx context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		NewStoryResource,
 		NewTinesResource,
+		NewTeamResource,
+		NewTeamMemberResource,
+		NewFolderResource,
+		NewCredentialTextResource,
+		NewCredentialOAuthResource,
+		NewCredentialAWSResource,
+		NewCredentialJWTResource,
+		NewCredentialHTTPRequestResource,
+		NewCredentialMTLSResource,
+		NewActionResource,
+		NewLinkResource,
+		NewNoteResource,
+		NewPageResource,
+		NewStoryVersionResource,
+		NewChangeRequestResource,
+		NewStoryDraftResource,
+		NewCaseInputResource,
+		NewCaseMetadataFieldResource,
+		NewRecordTypeResource,
 	}
 }
 
-// DataSources returns the available data sources (currently none).
+// DataSources returns the available Tines API data sources.
 func (p *TinesProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewUsersDataSource,
+		NewCredentialDataSource,
+		NewCredentialsDataSource,
+		NewActionsDataSource,
+		NewAuditLogsDataSource,
+		NewSendToStoryTargetsDataSource,
+		NewRecordsDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
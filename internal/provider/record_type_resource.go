@@ -0,0 +1,474 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// recordTypeResource is the resource implementation.
+type recordTypeResource struct {
+	client *tines.Client
+}
+
+type recordTypeResourceModel struct {
+	ID     types.Int64            `tfsdk:"id"`
+	TeamID types.Int64            `tfsdk:"team_id"`
+	Name   types.String           `tfsdk:"name"`
+	Fields []recordTypeFieldModel `tfsdk:"field"`
+}
+
+type recordTypeFieldModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Options types.List   `tfsdk:"options"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &recordTypeResource{}
+	_ resource.ResourceWithConfigure      = &recordTypeResource{}
+	_ resource.ResourceWithImportState    = &recordTypeResource{}
+	_ resource.ResourceWithValidateConfig = &recordTypeResource{}
+	_ resource.ResourceWithModifyPlan     = &recordTypeResource{}
+)
+
+// NewRecordTypeResource is a helper function to simplify the provider implementation.
+func NewRecordTypeResource() resource.Resource {
+	return &recordTypeResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *recordTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record_type"
+}
+
+const RECORD_TYPE_RESOURCE_DESCRIPTION = `
+A Tines Record Type defines the schema of the records that stories in a team can write, such as phishing verdicts.
+Fields can be added to a record type in place. Removing a field, or changing its type, deletes the values stored in that
+field for every existing record, so Terraform shows a warning when a plan would do this.`
+
+// Schema defines the schema for the resource.
+func (r *recordTypeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: RECORD_TYPE_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this record type.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int64Attribute{
+				Description: "The ID of the Tines Team that the record type belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the record type.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"field": schema.ListNestedBlock{
+				Description: "A field of the record type. At least one field must be defined.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the field. Field names must be unique within the record type.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of value stored in the field (TEXT, NUMBER, BOOLEAN, TIMESTAMP, OPTIONS).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("TEXT", "NUMBER", "BOOLEAN", "TIMESTAMP", "OPTIONS"),
+							},
+						},
+						"options": schema.ListAttribute{
+							Description: "The list of values that can be selected. Required when type is OPTIONS.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that field names are unique and that options are only set on OPTIONS fields.
+func (r *recordTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	fields, diags := r.getFields(ctx, req.Config.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || fields == nil {
+		return
+	}
+
+	names := map[string]bool{}
+	for i, field := range fields {
+		fieldPath := path.Root("field").AtListIndex(i)
+
+		if !field.Name.IsUnknown() {
+			if names[field.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					fieldPath.AtName("name"),
+					"Duplicate Record Field",
+					fmt.Sprintf("The field name %q is used more than once. Field names must be unique within the record type.", field.Name.ValueString()),
+				)
+			}
+			names[field.Name.ValueString()] = true
+		}
+
+		if field.Type.IsUnknown() {
+			continue
+		}
+
+		if field.Type.ValueString() == "OPTIONS" && field.Options.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				fieldPath.AtName("options"),
+				"Missing Field Options",
+				"The options attribute must be set when the field type is OPTIONS.",
+			)
+		}
+
+		if field.Type.ValueString() != "OPTIONS" && !field.Options.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				fieldPath.AtName("options"),
+				"Invalid Field Options",
+				"The options attribute can only be set when the field type is OPTIONS.",
+			)
+		}
+	}
+}
+
+// ModifyPlan warns when fields holding existing record data would be removed.
+func (r *recordTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	stateFields, diags := r.getFields(ctx, req.State.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planFields, diags := r.getFields(ctx, req.Plan.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || planFields == nil {
+		return
+	}
+
+	planTypes := map[string]types.String{}
+	for _, field := range planFields {
+		// The fields can't be compared until all of their names are known.
+		if field.Name.IsUnknown() {
+			return
+		}
+		planTypes[field.Name.ValueString()] = field.Type
+	}
+
+	for _, field := range stateFields {
+		name := field.Name.ValueString()
+
+		planType, ok := planTypes[name]
+		if !ok {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("field"),
+				"Record Field Will Be Removed",
+				fmt.Sprintf("The field %q will be removed from the record type. "+
+					"The values stored in this field for existing records will be permanently deleted.", name),
+			)
+			continue
+		}
+
+		if !planType.IsUnknown() && !planType.Equal(field.Type) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("field"),
+				"Record Field Type Will Change",
+				fmt.Sprintf("The type of the field %q will change from %s to %s. "+
+					"The values stored in this field for existing records will be permanently deleted.", name, field.Type.ValueString(), planType.ValueString()),
+			)
+		}
+	}
+}
+
+// Create creates a new Tines Record Type and sets the initial Terraform state.
+func (r *recordTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Record Type")
+
+	var plan recordTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newRecordType := tines.RecordType{
+		TeamID: int(plan.TeamID.ValueInt64()),
+	}
+
+	diags = r.convertPlanToRecordType(ctx, &plan, &newRecordType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType, err := r.client.CreateRecordType(ctx, &newRecordType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Record Type",
+			"Could not create record type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertRecordTypeToPlan(ctx, &plan, recordType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *recordTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState recordTypeResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetRecordType(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := r.convertRecordTypeToPlan(ctx, &localState, remoteState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the Tines Record Type in place and sets the updated Terraform state on success.
+func (r *recordTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Record Type")
+
+	var plan recordTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var recordTypeUpdate tines.RecordType
+	diags = r.convertPlanToRecordType(ctx, &plan, &recordTypeUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType, err := r.client.UpdateRecordType(ctx, int(plan.ID.ValueInt64()), &recordTypeUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Record Type",
+			"Could not update record type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	diags = r.convertRecordTypeToPlan(ctx, &plan, recordType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Record Type and removes the Terraform state on success.
+func (r *recordTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Record Type")
+
+	// Retrieve values from state
+	var state recordTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRecordType(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Record Type",
+			"Could not delete record type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *recordTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Record Type")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Record Type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *recordTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Reads the field blocks from a config, plan or state. The blocks may be generated by a
+// dynamic block that isn't known yet, in which case no fields are returned.
+func (r *recordTypeResource) getFields(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics) (fields []recordTypeFieldModel, diags diag.Diagnostics) {
+	var fieldList types.List
+	diags = getAttribute(ctx, path.Root("field"), &fieldList)
+	if diags.HasError() || fieldList.IsNull() || fieldList.IsUnknown() {
+		return nil, diags
+	}
+
+	fields = []recordTypeFieldModel{}
+	diags = fieldList.ElementsAs(ctx, &fields, false)
+
+	return fields, diags
+}
+
+// Sets the attributes of an API request body. The team of a record type can't be changed
+// after it is created, so it is set separately in Create. The full list of fields is always
+// sent, and Tines matches existing fields by name.
+func (r *recordTypeResource) convertPlanToRecordType(ctx context.Context, plan *recordTypeResourceModel, recordType *tines.RecordType) (diags diag.Diagnostics) {
+	recordType.Name = plan.Name.ValueString()
+
+	recordType.Fields = []tines.RecordField{}
+	for _, field := range plan.Fields {
+		recordField := tines.RecordField{
+			Name: field.Name.ValueString(),
+			Type: field.Type.ValueString(),
+		}
+
+		if !field.Options.IsNull() && !field.Options.IsUnknown() {
+			diags = field.Options.ElementsAs(ctx, &recordField.Options, false)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		recordType.Fields = append(recordType.Fields, recordField)
+	}
+
+	return diags
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *recordTypeResource) convertRecordTypeToPlan(ctx context.Context, plan *recordTypeResourceModel, recordType *tines.RecordType) (diags diag.Diagnostics) {
+	plan.ID = types.Int64Value(int64(recordType.ID))
+	plan.TeamID = types.Int64Value(int64(recordType.TeamID))
+	plan.Name = types.StringValue(recordType.Name)
+
+	plan.Fields = []recordTypeFieldModel{}
+	for _, recordField := range recordType.Fields {
+		field := recordTypeFieldModel{
+			Name:    types.StringValue(recordField.Name),
+			Type:    types.StringValue(recordField.Type),
+			Options: types.ListNull(types.StringType),
+		}
+
+		if len(recordField.Options) > 0 {
+			field.Options, diags = types.ListValueFrom(ctx, types.StringType, recordField.Options)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		plan.Fields = append(plan.Fields, field)
+	}
+
+	return diags
+}
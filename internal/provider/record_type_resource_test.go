@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesRecordType_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the record type with a single field.
+				Config: providerConfig + testAccCreateTinesRecordType(`
+	field {
+		name = "Sender"
+		type = "TEXT"
+	}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_record_type.test_record_type",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_record_type.test_record_type",
+						tfjsonpath.New("field"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+			{
+				// Adding a field updates the record type in place.
+				Config: providerConfig + testAccCreateTinesRecordType(`
+	field {
+		name = "Sender"
+		type = "TEXT"
+	}
+
+	field {
+		name = "Verdict"
+		type = "OPTIONS"
+		options = ["Malicious", "Benign"]
+	}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_record_type.test_record_type", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_record_type.test_record_type",
+						tfjsonpath.New("field").AtSliceIndex(1).AtMapKey("options"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("Malicious"),
+							knownvalue.StringExact("Benign"),
+						}),
+					),
+				},
+			},
+			{
+				// Import the existing record type.
+				ResourceName:      "tines_record_type.test_record_type",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTinesRecordType_duplicateField(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccCreateTinesRecordType(`
+	field {
+		name = "Sender"
+		type = "TEXT"
+	}
+
+	field {
+		name = "Sender"
+		type = "NUMBER"
+	}
+`),
+				ExpectError: regexp.MustCompile("Duplicate Record Field"),
+			},
+		},
+	})
+}
+
+func testAccCreateTinesRecordType(fields string) string {
+	return fmt.Sprintf(`
+resource "tines_record_type" "test_record_type" {
+	team_id = 30906
+	name = "Terraform Test Record Type"
+%s}
+`, fields)
+}
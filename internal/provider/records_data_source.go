@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+	"github.com/tines/terraform-provider-tines/internal/utils"
+)
+
+// recordsDataSource is the data source implementation.
+type recordsDataSource struct {
+	client *tines.Client
+}
+
+type recordsDataSourceModel struct {
+	RecordTypeID  types.Int64         `tfsdk:"record_type_id"`
+	Filters       []recordFilterModel `tfsdk:"filters"`
+	SortField     types.String        `tfsdk:"sort_field"`
+	SortDirection types.String        `tfsdk:"sort_direction"`
+	Limit         types.Int64         `tfsdk:"limit"`
+	Records       types.Dynamic       `tfsdk:"records"`
+}
+
+type recordFilterModel struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &recordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &recordsDataSource{}
+)
+
+// NewRecordsDataSource is a helper function to simplify the provider implementation.
+func NewRecordsDataSource() datasource.DataSource {
+	return &recordsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *recordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_records"
+}
+
+const RECORDS_DATA_SOURCE_DESCRIPTION = `
+Use this data source to query the records of a Tines Record Type, such as an allowlist or a routing table maintained in
+Tines. Records can be filtered by field value, sorted and limited. Each record is returned as an object keyed by field
+name, so the results can be used directly in other resources, for example to generate the value of a tines_resource.`
+
+// Schema defines the schema for the data source.
+func (d *recordsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: RECORDS_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"record_type_id": schema.Int64Attribute{
+				Description: "The ID of the record type to query.",
+				Required:    true,
+			},
+			"filters": schema.ListNestedAttribute{
+				Description: "Only return records that match all of these filters.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "The name of the field to filter on.",
+							Required:    true,
+						},
+						"operator": schema.StringAttribute{
+							Description: "How the field is compared with the value (EQUAL, NOT_EQUAL, CONTAINS, GREATER_THAN, LESS_THAN). default: EQUAL.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("EQUAL", "NOT_EQUAL", "CONTAINS", "GREATER_THAN", "LESS_THAN"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value to compare the field with.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"sort_field": schema.StringAttribute{
+				Description: "The name of the field to sort the records by. If not set, the most recent records are returned first.",
+				Optional:    true,
+			},
+			"sort_direction": schema.StringAttribute{
+				Description: "The direction to sort the records in (ASC, DESC). default: ASC.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ASC", "DESC"),
+					stringvalidator.AlsoRequires(path.MatchRoot("sort_field")),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of records to return. If not set, all matching records are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"records": schema.DynamicAttribute{
+				Description: "The list of matching records. Each record is an object keyed by field name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *recordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Records")
+
+	var state recordsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := tines.RecordQuery{
+		RecordTypeID: int(state.RecordTypeID.ValueInt64()),
+		Filters:      []tines.RecordFilter{},
+	}
+
+	for _, filter := range state.Filters {
+		operator := "EQUAL"
+		if !filter.Operator.IsNull() {
+			operator = filter.Operator.ValueString()
+		}
+
+		query.Filters = append(query.Filters, tines.RecordFilter{
+			FieldName: filter.Field.ValueString(),
+			Operator:  operator,
+			Value:     filter.Value.ValueString(),
+		})
+	}
+
+	if !state.SortField.IsNull() {
+		query.OrderBy = state.SortField.ValueString()
+		query.Direction = "ASC"
+	}
+
+	if !state.SortDirection.IsNull() {
+		query.Direction = state.SortDirection.ValueString()
+	}
+
+	if !state.Limit.IsNull() {
+		query.Limit = int(state.Limit.ValueInt64())
+	}
+
+	records, err := d.client.ListRecords(ctx, &query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tines Records",
+			"An unexpected error occurred while attempting to list records. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	rows := []map[string]any{}
+	for _, record := range records {
+		rows = append(rows, record.Fields)
+	}
+
+	rowsValue, diags := utils.DynamicValueFromAny(ctx, rows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Records = rowsValue
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *recordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tines/go-sdk/tines"
+)
+
+func TestAccTinesRecordsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccTinesRecordsDataSourceSortWithoutField(),
+				ExpectError: regexp.MustCompile("sort_field"),
+			},
+			{
+				// Create the record type and seed it with records, since records can't be managed by Terraform.
+				Config: providerConfig + testAccTinesRecordsDataSourceRecordType(),
+				Check: testAccSeedTinesRecords("tines_record_type.test_records_type", []map[string]any{
+					{"Domain": "alpha.example.com", "Priority": 1},
+					{"Domain": "beta.example.com", "Priority": 2},
+					{"Domain": "gamma.example.org", "Priority": 3},
+				}),
+			},
+			{
+				Config: providerConfig + testAccTinesRecordsDataSourceRecordType() + testAccTinesRecordsDataSourceQueries(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// Only the records matching the filter are returned, sorted in descending order.
+					statecheck.ExpectKnownValue(
+						"data.tines_records.test_filtered",
+						tfjsonpath.New("records"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.tines_records.test_filtered",
+						tfjsonpath.New("records").AtSliceIndex(0).AtMapKey("Domain"),
+						knownvalue.StringExact("beta.example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.tines_records.test_filtered",
+						tfjsonpath.New("records").AtSliceIndex(1).AtMapKey("Domain"),
+						knownvalue.StringExact("alpha.example.com"),
+					),
+					// The limit truncates the sorted records.
+					statecheck.ExpectKnownValue(
+						"data.tines_records.test_limited",
+						tfjsonpath.New("records"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.tines_records.test_limited",
+						tfjsonpath.New("records").AtSliceIndex(0).AtMapKey("Domain"),
+						knownvalue.StringExact("gamma.example.org"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// Creates records of the given record type directly through the API.
+func testAccSeedTinesRecords(resourceName string, rows []map[string]any) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		recordTypeID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("could not determine the ID of %s: %s", resourceName, err.Error())
+		}
+
+		client, err := tines.NewClient(
+			tines.SetTenantUrl(os.Getenv("TINES_TENANT")),
+			tines.SetApiKey(os.Getenv("TINES_API_KEY")),
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, fields := range rows {
+			record := tines.Record{
+				RecordTypeID: recordTypeID,
+				Fields:       fields,
+			}
+			if _, err := client.CreateRecord(context.Background(), &record); err != nil {
+				return fmt.Errorf("could not create record: %s", err.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTinesRecordsDataSourceSortWithoutField() string {
+	return `
+data "tines_records" "test_bad_sort" {
+	record_type_id = 1
+	sort_direction = "DESC"
+}
+	`
+}
+
+func testAccTinesRecordsDataSourceRecordType() string {
+	return `
+resource "tines_record_type" "test_records_type" {
+	team_id = 30906
+	name = "Terraform Test Records Type"
+
+	field {
+		name = "Domain"
+		type = "TEXT"
+	}
+
+	field {
+		name = "Priority"
+		type = "NUMBER"
+	}
+}
+	`
+}
+
+func testAccTinesRecordsDataSourceQueries() string {
+	return `
+data "tines_records" "test_filtered" {
+	record_type_id = tines_record_type.test_records_type.id
+
+	filters = [
+		{
+			field = "Domain"
+			operator = "CONTAINS"
+			value = "example.com"
+		}
+	]
+
+	sort_field = "Domain"
+	sort_direction = "DESC"
+}
+
+data "tines_records" "test_limited" {
+	record_type_id = tines_record_type.test_records_type.id
+	sort_field = "Priority"
+	sort_direction = "DESC"
+	limit = 1
+}
+	`
+}
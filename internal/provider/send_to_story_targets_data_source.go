@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// sendToStoryTargetsDataSource is the data source implementation.
+type sendToStoryTargetsDataSource struct {
+	client *tines.Client
+}
+
+type sendToStoryTargetsDataSourceModel struct {
+	TeamID       types.Int64              `tfsdk:"team_id"`
+	AccessSource types.String             `tfsdk:"send_to_story_access_source"`
+	Stories      []sendToStoryTargetModel `tfsdk:"stories"`
+}
+
+type sendToStoryTargetModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Guid            types.String `tfsdk:"guid"`
+	TeamID          types.Int64  `tfsdk:"team_id"`
+	STSAccessSource types.String `tfsdk:"send_to_story_access_source"`
+	STSAccess       types.String `tfsdk:"send_to_story_access"`
+	InputSchema     types.String `tfsdk:"input_schema"`
+	OutputSchema    types.String `tfsdk:"output_schema"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sendToStoryTargetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &sendToStoryTargetsDataSource{}
+)
+
+// NewSendToStoryTargetsDataSource is a helper function to simplify the provider implementation.
+func NewSendToStoryTargetsDataSource() datasource.DataSource {
+	return &sendToStoryTargetsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *sendToStoryTargetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_send_to_story_targets"
+}
+
+const SEND_TO_STORY_TARGETS_DATA_SOURCE_DESCRIPTION = `
+Use this data source to list the stories that a Tines Team is allowed to call with Send to Story, taking each story's
+send_to_story_access and shared_team_slugs settings into account. This can be used to validate that every Send to Story
+target referenced in a story export is reachable from the team it will be imported into.`
+
+// Schema defines the schema for the data source.
+func (d *sendToStoryTargetsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: SEND_TO_STORY_TARGETS_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int64Attribute{
+				Description: "The ID of the calling team.",
+				Required:    true,
+			},
+			"send_to_story_access_source": schema.StringAttribute{
+				Description: "Only return stories that can be used from this source (STS, STS_AND_WORKBENCH, WORKBENCH).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("STS", "STS_AND_WORKBENCH", "WORKBENCH"),
+				},
+			},
+			"stories": schema.ListNestedAttribute{
+				Description: "The list of stories the team can call with Send to Story.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The Tines-generated identifier for this story.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the Tines story.",
+							Computed:    true,
+						},
+						"guid": schema.StringAttribute{
+							Description: "The globally unique identifier of the story. Story exports refer to Send to Story targets by this value.",
+							Computed:    true,
+						},
+						"team_id": schema.Int64Attribute{
+							Description: "The ID of the team that this story belongs to.",
+							Computed:    true,
+						},
+						"send_to_story_access_source": schema.StringAttribute{
+							Description: "Where the Send to Story can be used (STS, STS_AND_WORKBENCH, WORKBENCH).",
+							Computed:    true,
+						},
+						"send_to_story_access": schema.StringAttribute{
+							Description: "Who is allowed to send to this story (TEAM, GLOBAL, SPECIFIC_TEAMS).",
+							Computed:    true,
+						},
+						"input_schema": schema.StringAttribute{
+							Description: "The input schema of the story as a JSON string. Use jsondecode() to access individual values.",
+							Computed:    true,
+						},
+						"output_schema": schema.StringAttribute{
+							Description: "The output schema of the story as a JSON string. Use jsondecode() to access individual values.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sendToStoryTargetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Send to Story Targets")
+
+	var state sendToStoryTargetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, err := d.client.ListSendToStoryTargets(ctx, int(state.TeamID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tines Send to Story Targets",
+			"An unexpected error occurred while attempting to list Send to Story targets. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Stories = []sendToStoryTargetModel{}
+	for _, target := range targets {
+		// STS_AND_WORKBENCH targets are usable from either source, so they match both filters.
+		if !state.AccessSource.IsNull() && target.STSAccessSource != "STS_AND_WORKBENCH" &&
+			target.STSAccessSource != state.AccessSource.ValueString() {
+			continue
+		}
+
+		inputSchema, err := json.Marshal(target.InputSchema)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tines Send to Story Targets",
+				fmt.Sprintf("Could not encode the input schema of story %d, unexpected error: %s", target.StoryID, err.Error()),
+			)
+			return
+		}
+
+		outputSchema, err := json.Marshal(target.OutputSchema)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tines Send to Story Targets",
+				fmt.Sprintf("Could not encode the output schema of story %d, unexpected error: %s", target.StoryID, err.Error()),
+			)
+			return
+		}
+
+		state.Stories = append(state.Stories, sendToStoryTargetModel{
+			ID:              types.Int64Value(int64(target.StoryID)),
+			Name:            types.StringValue(target.Name),
+			Guid:            types.StringValue(target.Guid),
+			TeamID:          types.Int64Value(int64(target.TeamID)),
+			STSAccessSource: types.StringValue(target.STSAccessSource),
+			STSAccess:       types.StringValue(target.STSAccess),
+			InputSchema:     types.StringValue(string(inputSchema)),
+			OutputSchema:    types.StringValue(string(outputSchema)),
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sendToStoryTargetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
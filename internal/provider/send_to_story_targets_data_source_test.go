@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesSendToStoryTargetsDataSource_byTeam(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccTinesSendToStoryTargetsDataSourceByTeam(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tines_send_to_story_targets.test_targets",
+						tfjsonpath.New("stories"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTinesSendToStoryTargetsDataSourceByTeam() string {
+	return `
+data "tines_send_to_story_targets" "test_targets" {
+	team_id = 30906
+	send_to_story_access_source = "STS"
+}
+	`
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// storyDraftResource is the resource implementation.
+type storyDraftResource struct {
+	client *tines.Client
+}
+
+type storyDraftResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	StoryID     types.Int64  `tfsdk:"story_id"`
+	Name        types.String `tfsdk:"name"`
+	Data        types.String `tfsdk:"data"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Priority    types.Bool   `tfsdk:"priority"`
+	DraftID     types.Int64  `tfsdk:"draft_id"`
+	DiffSummary types.String `tfsdk:"diff_summary"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &storyDraftResource{}
+	_ resource.ResourceWithConfigure   = &storyDraftResource{}
+	_ resource.ResourceWithImportState = &storyDraftResource{}
+)
+
+// NewStoryDraftResource is a helper function to simplify the provider implementation.
+func NewStoryDraftResource() resource.Resource {
+	return &storyDraftResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *storyDraftResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_story_draft"
+}
+
+const STORY_DRAFT_RESOURCE_DESCRIPTION = `
+A Tines Story Draft is a named set of pending changes to a story with change control enabled. Changes are made to the
+draft instead of the live story, either from an exported story or from individual story attributes, so that reviewers
+can inspect them in the Tines UI before they are published with a tines_change_request resource. Destroying this
+resource discards the draft.`
+
+// Schema defines the schema for the resource.
+func (r *storyDraftResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: STORY_DRAFT_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the story draft, in the format story_id:draft_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story with change control enabled.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the draft.",
+				Required:    true,
+			},
+			"data": schema.StringAttribute{
+				Description: "A local JSON file containing an exported Tines story to apply to the draft. Setting this value can't be combined with the description, disabled and priority attributes.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A user-defined description of the story in the draft.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("data")),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Boolean flag indicating whether the story in the draft is disabled from running.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("data")),
+				},
+			},
+			"priority": schema.BoolAttribute{
+				Description: "Boolean flag indicating whether the story in the draft runs with high priority.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("data")),
+				},
+			},
+			"draft_id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this draft. Use this to submit the draft with a tines_change_request resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"diff_summary": schema.StringAttribute{
+				Description: "A summary of the differences between the draft and the live story.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates a new Tines Story Draft and sets the initial Terraform state.
+func (r *storyDraftResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Story Draft")
+
+	var plan storyDraftResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newDraft tines.StoryDraft
+	diags = r.convertPlanToStoryDraft(&plan, &newDraft)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	draft, err := r.client.CreateStoryDraft(ctx, int(plan.StoryID.ValueInt64()), &newDraft)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Story Draft",
+			"Could not create story draft, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertStoryDraftToPlan(&plan, draft)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *storyDraftResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState storyDraftResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetStoryDraft(ctx, int(localState.StoryID.ValueInt64()), int(localState.DraftID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.convertStoryDraftToPlan(&localState, remoteState)
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update applies the planned changes to the Tines Story Draft and sets the updated Terraform state on success.
+func (r *storyDraftResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Story Draft")
+
+	var plan storyDraftResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var draftUpdate tines.StoryDraft
+	diags = r.convertPlanToStoryDraft(&plan, &draftUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	draft, err := r.client.UpdateStoryDraft(ctx, int(plan.StoryID.ValueInt64()), int(plan.DraftID.ValueInt64()), &draftUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Story Draft",
+			"Could not update story draft, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertStoryDraftToPlan(&plan, draft)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete discards the Tines Story Draft and removes the Terraform state on success.
+func (r *storyDraftResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Story Draft")
+
+	// Retrieve values from state
+	var state storyDraftResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStoryDraft(ctx, int(state.StoryID.ValueInt64()), int(state.DraftID.ValueInt64()))
+	if err != nil {
+		// A draft that has been published through a change request no longer exists.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Story Draft",
+			"Could not delete story draft, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *storyDraftResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Story Draft")
+	// Retrieve the story and draft IDs from an import ID in the format story_id:draft_id.
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Expected an import ID in the format story_id:draft_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	storyID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Story, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	draftID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Story Draft, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("story_id"), storyID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("draft_id"), draftID)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *storyDraftResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// This is reused in the Create and Update methods. Story attributes that aren't set are left
+// unchanged in the draft.
+func (r *storyDraftResource) convertPlanToStoryDraft(plan *storyDraftResourceModel, draft *tines.StoryDraft) (diags diag.Diagnostics) {
+	draft.Name = plan.Name.ValueString()
+
+	if !plan.Data.IsNull() && !plan.Data.IsUnknown() {
+		var data map[string]any
+
+		err := json.Unmarshal([]byte(plan.Data.ValueString()), &data)
+		if err != nil {
+			diags.AddAttributeError(path.Root("data"), "Invalid JSON in file", err.Error())
+			return diags
+		}
+		draft.Data = data
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		draft.Description = plan.Description.ValueStringPointer()
+	}
+
+	if !plan.Disabled.IsNull() && !plan.Disabled.IsUnknown() {
+		draft.Disabled = plan.Disabled.ValueBoolPointer()
+	}
+
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		draft.Priority = plan.Priority.ValueBoolPointer()
+	}
+
+	return diags
+}
+
+// This is reused in the Create, Read and Update methods. The story export only exists in
+// Terraform, and story attributes are only refreshed when they are managed by this resource.
+func (r *storyDraftResource) convertStoryDraftToPlan(plan *storyDraftResourceModel, draft *tines.StoryDraft) {
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", draft.StoryID, draft.ID))
+	plan.StoryID = types.Int64Value(int64(draft.StoryID))
+	plan.Name = types.StringValue(draft.Name)
+	plan.DraftID = types.Int64Value(int64(draft.ID))
+	plan.DiffSummary = types.StringValue(draft.DiffSummary)
+
+	if !plan.Description.IsNull() && draft.Description != nil {
+		plan.Description = types.StringPointerValue(draft.Description)
+	}
+
+	if !plan.Disabled.IsNull() && draft.Disabled != nil {
+		plan.Disabled = types.BoolPointerValue(draft.Disabled)
+	}
+
+	if !plan.Priority.IsNull() && draft.Priority != nil {
+		plan.Priority = types.BoolPointerValue(draft.Priority)
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesStoryDraft_attributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a draft that changes individual story attributes.
+				Config: providerConfig + testAccCreateTinesStoryDraft("Terraform Test Draft", "Updated in a draft"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_story_draft.test_story_draft",
+							tfjsonpath.New("draft_id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_story_draft.test_story_draft",
+						tfjsonpath.New("diff_summary"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				// Changing the draft contents updates it in place.
+				Config: providerConfig + testAccCreateTinesStoryDraft("Terraform Test Draft", "Updated again in a draft"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_story_draft.test_story_draft", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(
+							"tines_story_draft.test_story_draft",
+							tfjsonpath.New("diff_summary"),
+						),
+					},
+				},
+			},
+			{
+				// Import the existing draft.
+				ResourceName:            "tines_story_draft.test_story_draft",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description", "disabled"},
+			},
+		},
+	})
+}
+
+func TestAccTinesStoryDraft_data(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a draft from a story export.
+				Config: providerConfig + `
+resource "tines_story" "test_story_draft_story" {
+	team_id = 30906
+	name = "Terraform Test Story Draft Story"
+	change_control_enabled = true
+}
+
+resource "tines_story_draft" "test_story_draft" {
+	story_id = tines_story.test_story_draft_story.id
+	name = "Terraform Test Draft From Export"
+	data = file("${path.module}/testdata/test-story.json")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_story_draft.test_story_draft",
+						tfjsonpath.New("draft_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccCreateTinesStoryDraft(name string, description string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_story_draft_story" {
+	team_id = 30906
+	name = "Terraform Test Story Draft Story"
+	change_control_enabled = true
+}
+
+resource "tines_story_draft" "test_story_draft" {
+	story_id = tines_story.test_story_draft_story.id
+	name = %q
+	description = %q
+	disabled = false
+}
+`, name, description)
+}
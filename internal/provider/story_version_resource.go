@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// storyVersionResource is the resource implementation.
+type storyVersionResource struct {
+	client *tines.Client
+}
+
+type storyVersionResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	StoryID   types.Int64  `tfsdk:"story_id"`
+	Name      types.String `tfsdk:"name"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	VersionID types.Int64  `tfsdk:"version_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &storyVersionResource{}
+	_ resource.ResourceWithConfigure   = &storyVersionResource{}
+	_ resource.ResourceWithImportState = &storyVersionResource{}
+)
+
+// NewStoryVersionResource is a helper function to simplify the provider implementation.
+func NewStoryVersionResource() resource.Resource {
+	return &storyVersionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *storyVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_story_version"
+}
+
+const STORY_VERSION_RESOURCE_DESCRIPTION = `
+A Tines Story Version is a named snapshot of a story that it can be rolled back to. A new version is saved whenever the
+triggers map changes, for example before applying a new story export. Destroying this resource only removes it from
+the Terraform state: the version is kept in Tines, so previous snapshots remain available for a rollback.`
+
+// Schema defines the schema for the resource.
+func (r *storyVersionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: STORY_VERSION_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the story version, in the format story_id:version_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"story_id": schema.Int64Attribute{
+				Description: "The ID of the story to save a version of.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the story version.",
+				Required:    true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause a new version to be saved when they change, such as a hash of the story export.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this story version.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The ISO 8601 Timestamp representing date and time the story version was saved.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create saves a new Tines Story Version and sets the initial Terraform state.
+func (r *storyVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Story Version")
+
+	var plan storyVersionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newVersion := tines.StoryVersion{
+		Name: plan.Name.ValueString(),
+	}
+
+	version, err := r.client.CreateStoryVersion(ctx, int(plan.StoryID.ValueInt64()), &newVersion)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Story Version",
+			"Could not create story version, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertStoryVersionToPlan(&plan, version)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *storyVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState storyVersionResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetStoryVersion(ctx, int(localState.StoryID.ValueInt64()), int(localState.VersionID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.convertStoryVersionToPlan(&localState, remoteState)
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update renames the Tines Story Version in place and sets the updated Terraform state on success.
+func (r *storyVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Story Version")
+
+	var plan storyVersionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionUpdate := tines.StoryVersion{
+		Name: plan.Name.ValueString(),
+	}
+
+	version, err := r.client.UpdateStoryVersion(ctx, int(plan.StoryID.ValueInt64()), int(plan.VersionID.ValueInt64()), &versionUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Story Version",
+			"Could not update story version, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertStoryVersionToPlan(&plan, version)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Tines Story Version from the Terraform state. The version itself is kept
+// in Tines, since replacing this resource must not discard an earlier rollback point.
+func (r *storyVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing Tines Story Version from state, the version is kept in Tines")
+}
+
+func (r *storyVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Story Version")
+	// Retrieve the story and version IDs from an import ID in the format story_id:version_id.
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Expected an import ID in the format story_id:version_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	storyID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Story, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	versionID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Story Version, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("story_id"), storyID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version_id"), versionID)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *storyVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// This is reused in the Create, Read and Update methods. The triggers only exist in
+// Terraform, so they are left untouched.
+func (r *storyVersionResource) convertStoryVersionToPlan(plan *storyVersionResourceModel, version *tines.StoryVersion) {
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", version.StoryID, version.ID))
+	plan.StoryID = types.Int64Value(int64(version.StoryID))
+	plan.Name = types.StringValue(version.Name)
+	plan.VersionID = types.Int64Value(int64(version.ID))
+	plan.CreatedAt = types.StringValue(version.CreatedAt)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesStoryVersion_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Save the first story version.
+				Config: providerConfig + testAccCreateTinesStoryVersion("Terraform Test Version", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_story_version.test_story_version",
+							tfjsonpath.New("version_id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_story_version.test_story_version",
+						tfjsonpath.New("created_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				// Renaming the version updates it in place.
+				Config: providerConfig + testAccCreateTinesStoryVersion("Terraform Test Version Renamed", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_story_version.test_story_version", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// Changing the triggers saves a new version.
+				Config: providerConfig + testAccCreateTinesStoryVersion("Terraform Test Version Renamed", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_story_version.test_story_version", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			{
+				// Import the existing story version.
+				ResourceName:            "tines_story_version.test_story_version",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func testAccCreateTinesStoryVersion(name string, revision string) string {
+	return fmt.Sprintf(`
+resource "tines_story" "test_story_version_story" {
+	team_id = 30906
+	name = "Terraform Test Story Version Story"
+}
+
+resource "tines_story_version" "test_story_version" {
+	story_id = tines_story.test_story_version_story.id
+	name = %q
+	triggers = {
+		revision = %q
+	}
+}
+	`, name, revision)
+}
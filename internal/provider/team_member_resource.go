@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// teamMemberResource is the resource implementation.
+type teamMemberResource struct {
+	client *tines.Client
+}
+
+type teamMemberResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	TeamID types.Int64  `tfsdk:"team_id"`
+	UserID types.Int64  `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamMemberResource{}
+	_ resource.ResourceWithConfigure   = &teamMemberResource{}
+	_ resource.ResourceWithImportState = &teamMemberResource{}
+)
+
+// NewTeamMemberResource is a helper function to simplify the provider implementation.
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *teamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+const TEAM_MEMBER_RESOURCE_DESCRIPTION = `
+A Tines Team Member binds an existing Tines user to a team with a given role. The user can be referenced either by ID
+or by email address. Changing the role updates the membership in place, and destroying this resource removes the user
+from the team without deleting the user.`
+
+// Schema defines the schema for the resource.
+func (r *teamMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: TEAM_MEMBER_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this team membership, in the format team_id:user_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int64Attribute{
+				Description: "The ID of the Tines Team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Description: "The ID of the user. Exactly one of user_id or email must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("email")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user. Exactly one of user_id or email must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user in the team (VIEWER, EDITOR, TEAM_ADMIN).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("VIEWER", "EDITOR", "TEAM_ADMIN"),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the user to the Tines Team and sets the initial Terraform state.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Team Member")
+
+	var plan teamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newMember := tines.TeamMember{
+		TeamID: int(plan.TeamID.ValueInt64()),
+		Role:   plan.Role.ValueString(),
+	}
+
+	if !plan.UserID.IsNull() && !plan.UserID.IsUnknown() {
+		newMember.UserID = int(plan.UserID.ValueInt64())
+	} else {
+		// Resolve the email address to a user ID, the same way the tines_users data source does.
+		users, err := r.client.ListUsers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Tines Team Member",
+				"Could not list users to resolve the email address, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		for _, user := range users {
+			if strings.EqualFold(user.Email, plan.Email.ValueString()) {
+				newMember.UserID = user.ID
+				break
+			}
+		}
+
+		if newMember.UserID == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"Tines User Not Found",
+				fmt.Sprintf("No user with the email address %q could be found.", plan.Email.ValueString()),
+			)
+			return
+		}
+	}
+
+	member, err := r.client.AddTeamMember(ctx, newMember.TeamID, &newMember)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Team Member",
+			"Could not add user to team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertTeamMemberToPlan(&plan, member)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState teamMemberResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetTeamMember(ctx, int(localState.TeamID.ValueInt64()), int(localState.UserID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.convertTeamMemberToPlan(&localState, remoteState)
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update changes the role of the team member in place and sets the updated Terraform state on success.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Team Member")
+
+	var plan teamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := int(plan.TeamID.ValueInt64())
+	userID := int(plan.UserID.ValueInt64())
+	memberUpdate := tines.TeamMember{
+		TeamID: teamID,
+		UserID: userID,
+		Role:   plan.Role.ValueString(),
+	}
+
+	member, err := r.client.UpdateTeamMember(ctx, teamID, userID, &memberUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Team Member",
+			"Could not update team member role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertTeamMemberToPlan(&plan, member)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the user from the Tines Team and removes the Terraform state on success.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Team Member")
+
+	// Retrieve values from state
+	var state teamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the user from the team. The user itself is left untouched.
+	err := r.client.RemoveTeamMember(ctx, int(state.TeamID.ValueInt64()), int(state.UserID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Team Member",
+			"Could not remove user from team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Team Member")
+	// Retrieve the team and user IDs from an import ID in the format team_id:user_id.
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Expected an import ID in the format team_id:user_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	teamID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	userID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *teamMemberResource) convertTeamMemberToPlan(plan *teamMemberResourceModel, member *tines.TeamMember) {
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", member.TeamID, member.UserID))
+	plan.TeamID = types.Int64Value(int64(member.TeamID))
+	plan.UserID = types.Int64Value(int64(member.UserID))
+	// Keep the configured spelling of the email address, since Tines matches them case-insensitively.
+	if !strings.EqualFold(plan.Email.ValueString(), member.Email) {
+		plan.Email = types.StringValue(member.Email)
+	}
+	plan.Role = types.StringValue(member.Role)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesTeamMember_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccCreateTinesTeamMemberBadConfig(),
+				ExpectError: regexp.MustCompile("No attribute specified when one \\(and only one\\) of"),
+			},
+			{
+				// Add the user to the team.
+				Config: providerConfig + testAccCreateTinesTeamMember("VIEWER"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_team_member.test_member",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_team_member.test_member",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("VIEWER"),
+					),
+				},
+			},
+			{
+				// Change the role of the user in place.
+				Config: providerConfig + testAccCreateTinesTeamMember("EDITOR"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tines_team_member.test_member", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_team_member.test_member",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("EDITOR"),
+					),
+				},
+			},
+			{
+				// Import the existing team membership.
+				ResourceName:            "tines_team_member.test_member",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"email"},
+			},
+		},
+	})
+}
+
+func testAccCreateTinesTeamMemberBadConfig() string {
+	return `
+resource "tines_team_member" "test_bad_config" {
+	team_id = 30906
+	role = "VIEWER"
+}
+	`
+}
+
+func testAccCreateTinesTeamMember(role string) string {
+	return `
+resource "tines_team" "test_member_team" {
+	name = "Terraform Test Team Member Team"
+}
+
+data "tines_users" "test_member_user" {
+	team_id = 30906
+}
+
+resource "tines_team_member" "test_member" {
+	team_id = tines_team.test_member_team.id
+	user_id = data.tines_users.test_member_user.users[0].id
+	role = "` + role + `"
+}
+	`
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// teamResource is the resource implementation.
+type teamResource struct {
+	client *tines.Client
+}
+
+type teamResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	KeepEventsFor types.Int64  `tfsdk:"keep_events_for"`
+	Slug          types.String `tfsdk:"slug"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamResource{}
+	_ resource.ResourceWithConfigure   = &teamResource{}
+	_ resource.ResourceWithImportState = &teamResource{}
+)
+
+// NewTeamResource is a helper function to simplify the provider implementation.
+func NewTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *teamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+const TEAM_RESOURCE_DESCRIPTION = `
+A Tines Team is the top-level boundary for stories, resources, credentials and folders. Managing teams with Terraform
+allows a new team workspace to be bootstrapped entirely from configuration, with other resources referencing the team ID.
+Deleting a Tines Team also deletes everything inside it.`
+
+// Schema defines the schema for the resource.
+func (r *teamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: TEAM_RESOURCE_DESCRIPTION,
+		Version:     0, // This needs to be incremented every time we change the schema, and accompanied by a schema migration.
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The Tines-generated identifier for this team.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the Tines Team.",
+				Required:    true,
+			},
+			"keep_events_for": schema.Int64Attribute{
+				Description: "The default event retention period in seconds for stories in this team.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Description: "An underscored representation of the team name, as used in shared_team_slugs attributes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates a new Tines Team and sets the initial Terraform state.
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Tines Team")
+
+	var plan teamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newTeam := tines.Team{
+		Name: plan.Name.ValueString(),
+	}
+
+	if !plan.KeepEventsFor.IsNull() && !plan.KeepEventsFor.IsUnknown() {
+		newTeam.KeepEventsFor = int(plan.KeepEventsFor.ValueInt64())
+	}
+
+	team, err := r.client.CreateTeam(ctx, &newTeam)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tines Team",
+			"Could not create team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertTeamToPlan(&plan, team)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Retrieve the current infrastructure state.
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var localState teamResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteState, err := r.client.GetTeam(ctx, int(localState.ID.ValueInt64()))
+	if err != nil {
+		// Treat HTTP 404 Not Found status as a signal to recreate resource
+		// and return early.
+		if tinesErr, ok := err.(tines.Error); ok {
+			if tinesErr.StatusCode == 404 {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	r.convertTeamToPlan(&localState, remoteState)
+
+	// Set refreshed state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &localState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update performs an in-place update of the Tines Team and sets the updated Terraform state on success.
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Tines Team")
+
+	var plan teamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUpdate := tines.Team{
+		Name: plan.Name.ValueString(),
+	}
+
+	if !plan.KeepEventsFor.IsNull() && !plan.KeepEventsFor.IsUnknown() {
+		teamUpdate.KeepEventsFor = int(plan.KeepEventsFor.ValueInt64())
+	}
+
+	team, err := r.client.UpdateTeam(ctx, int(plan.ID.ValueInt64()), &teamUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tines Team",
+			"Could not update team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate all the computed values in the plan.
+	r.convertTeamToPlan(&plan, team)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the Tines Team and removes the Terraform state on success.
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Tines Team")
+
+	// Retrieve values from state
+	var state teamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing team.
+	err := r.client.DeleteTeam(ctx, int(state.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tines Team",
+			"Could not delete team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing Tines Team")
+	// Retrieve import ID and save to id attribute
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			"Could not determine the ID of the Tines Team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// This is reused in the Create, Read and Update methods.
+func (r *teamResource) convertTeamToPlan(plan *teamResourceModel, team *tines.Team) {
+	plan.ID = types.Int64Value(int64(team.ID))
+	plan.Name = types.StringValue(team.Name)
+	plan.KeepEventsFor = types.Int64Value(int64(team.KeepEventsFor))
+	plan.Slug = types.StringValue(team.Slug)
+}
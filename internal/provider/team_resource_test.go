@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesTeam_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the Tines Team.
+				Config: providerConfig + testAccCreateTinesTeam(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectUnknownValue(
+							"tines_team.test_team",
+							tfjsonpath.New("id"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_team.test_team",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_team.test_team",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Terraform Test Team"),
+					),
+				},
+			},
+			{
+				// Rename the Tines Team in place.
+				Config: providerConfig + testAccUpdateTinesTeam(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectResourceAction("tines_team.test_team", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"tines_team.test_team",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Terraform Test Team Renamed"),
+					),
+					statecheck.ExpectKnownValue(
+						"tines_team.test_team",
+						tfjsonpath.New("keep_events_for"),
+						knownvalue.Int64Exact(86400),
+					),
+				},
+			},
+			{
+				// Import the existing Tines Team.
+				ResourceName:      "tines_team.test_team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCreateTinesTeam() string {
+	return `
+resource "tines_team" "test_team" {
+	name = "Terraform Test Team"
+}
+	`
+}
+
+func testAccUpdateTinesTeam() string {
+	return `
+resource "tines_team" "test_team" {
+	name = "Terraform Test Team Renamed"
+	keep_events_for = 86400
+}
+	`
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tines/go-sdk/tines"
+)
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *tines.Client
+}
+
+type usersDataSourceModel struct {
+	Emails types.List      `tfsdk:"emails"`
+	TeamID types.Int64     `tfsdk:"team_id"`
+	Users  []userItemModel `tfsdk:"users"`
+}
+
+type userItemModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	IsAdmin   types.Bool   `tfsdk:"is_admin"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+const USERS_DATA_SOURCE_DESCRIPTION = `
+Use this data source to look up Tines users by email address or by team membership. User IDs differ between tenants,
+so this allows attributes such as story owners or team memberships to be written in terms of email addresses instead.
+If both emails and team_id are set, only users matching both filters are returned.`
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: USERS_DATA_SOURCE_DESCRIPTION,
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.ListAttribute{
+				Description: "A list of email addresses to resolve. Every email address must match an existing user in the tenant.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"team_id": schema.Int64Attribute{
+				Description: "Only return users that are members of the Tines Team with this ID.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The list of matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The Tines-generated identifier for this user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "The first name of the user.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "The last name of the user.",
+							Computed:    true,
+						},
+						"is_admin": schema.BoolAttribute{
+							Description: "Boolean flag indicating whether the user is a tenant admin.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tines Users")
+
+	var state usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tines Users",
+			"An unexpected error occurred while attempting to list users. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Narrow the results down to members of the requested team, if one was specified.
+	if !state.TeamID.IsNull() {
+		members, err := d.client.ListTeamMembers(ctx, int(state.TeamID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tines Team Members",
+				"An unexpected error occurred while attempting to list team members. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+			return
+		}
+
+		memberIDs := make(map[int]bool, len(members))
+		for _, member := range members {
+			memberIDs[member.UserID] = true
+		}
+
+		var teamUsers []tines.User
+		for _, user := range users {
+			if memberIDs[user.ID] {
+				teamUsers = append(teamUsers, user)
+			}
+		}
+		users = teamUsers
+	}
+
+	// Resolve each requested email address, preserving the order they were given in.
+	// Email addresses are matched case-insensitively, the same way the Tines UI does.
+	if !state.Emails.IsNull() {
+		var emails []string
+		resp.Diagnostics.Append(state.Emails.ElementsAs(ctx, &emails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		usersByEmail := make(map[string]tines.User, len(users))
+		for _, user := range users {
+			usersByEmail[strings.ToLower(user.Email)] = user
+		}
+
+		var matchedUsers []tines.User
+		for _, email := range emails {
+			user, ok := usersByEmail[strings.ToLower(email)]
+			if !ok {
+				resp.Diagnostics.AddError(
+					"Tines User Not Found",
+					fmt.Sprintf("No user with the email address %q could be found. If team_id is set, the user must also be a member of that team.", email),
+				)
+				continue
+			}
+			matchedUsers = append(matchedUsers, user)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		users = matchedUsers
+	}
+
+	state.Users = []userItemModel{}
+	for _, user := range users {
+		state.Users = append(state.Users, userItemModel{
+			ID:        types.Int64Value(int64(user.ID)),
+			Email:     types.StringValue(user.Email),
+			FirstName: types.StringValue(user.FirstName),
+			LastName:  types.StringValue(user.LastName),
+			IsAdmin:   types.BoolValue(user.IsAdmin),
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tines.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Tines Client Configure Type",
+			fmt.Sprintf("Expected *tines.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTinesUsersDataSource_byTeam(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccTinesUsersDataSourceByTeam(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.tines_users.test_team_members",
+						tfjsonpath.New("users"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.tines_users.test_team_members",
+						tfjsonpath.New("users").AtSliceIndex(0).AtMapKey("id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTinesUsersDataSource_byEmail(t *testing.T) {
+	// Every attribute of the user found by email must match the same user listed by team.
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(
+			"data.tines_users.test_by_email",
+			tfjsonpath.New("users"),
+			knownvalue.ListSizeExact(1),
+		),
+	}
+	for _, attribute := range []string{"id", "email", "first_name", "last_name", "admin"} {
+		checks = append(checks, statecheck.CompareValuePairs(
+			"data.tines_users.test_by_email",
+			tfjsonpath.New("users").AtSliceIndex(0).AtMapKey(attribute),
+			"data.tines_users.test_team_members",
+			tfjsonpath.New("users").AtSliceIndex(0).AtMapKey(attribute),
+			compare.ValuesSame(),
+		))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Email addresses are matched case-insensitively.
+				Config:            providerConfig + testAccTinesUsersDataSourceByEmail(),
+				ConfigStateChecks: checks,
+			},
+			{
+				// Unknown email addresses are reported instead of being silently dropped.
+				Config: providerConfig + `
+data "tines_users" "test_missing_user" {
+	emails = ["terraform-test-missing-user@example.com"]
+}
+				`,
+				ExpectError: regexp.MustCompile("Tines User Not Found"),
+			},
+		},
+	})
+}
+
+func testAccTinesUsersDataSourceByEmail() string {
+	return testAccTinesUsersDataSourceByTeam() + `
+data "tines_users" "test_by_email" {
+	emails = [upper(data.tines_users.test_team_members.users[0].email)]
+}
+	`
+}
+
+func testAccTinesUsersDataSourceByTeam() string {
+	return `
+data "tines_users" "test_team_members" {
+	team_id = 30906
+}
+	`
+}
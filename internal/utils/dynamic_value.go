@@ -174,6 +174,24 @@ func SetUnderlyingDynamicValue(ctx context.Context, jsonValue string) (types.Dyn
 	return dynamicValue, diags
 }
 
+// DynamicValueFromAny converts a Go value decoded from a Tines API response, such as the
+// options of an action, to a Terraform Dynamic value. The value is encoded as JSON and passed
+// through SetUnderlyingDynamicValue, so it has the same representation as tines_resource values.
+//
+// Returns an error if the value can't be encoded as JSON.
+func DynamicValueFromAny(ctx context.Context, value any) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Unable to convert value to a dynamic value",
+			fmt.Sprintf("Could not encode the value as JSON, unexpected error: %s", err.Error()))
+		return types.DynamicNull(), diags
+	}
+
+	return SetUnderlyingDynamicValue(ctx, string(encoded))
+}
+
 // convertToTerraformValue recursively converts a parsed JSON value to a Terraform dynamic value.
 func convertToTerraformValue(ctx context.Context, value any) (types.Dynamic, diag.Diagnostics) {
 	var diags diag.Diagnostics